@@ -0,0 +1,332 @@
+// Package rb provides Ruby-inspired utility methods for Go types.
+package rb
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// AnyArray is an array type that supports any values.
+// It is what heterogeneous results such as Hash.Keys are returned as, and
+// offers the same Enumerable methods as Array plus typed extraction helpers.
+type AnyArray []any
+
+// ErrTypeMismatch is returned when an AnyArray element cannot be converted to the requested type.
+var ErrTypeMismatch = errors.New("rb: type mismatch")
+
+// ArrayOf converts an AnyArray into an Array[T], returning an error wrapping
+// ErrTypeMismatch if any element is not of type T.
+// Example: ArrayOf[String](AnyArray{String("a"), String("b")}) -> ["a", "b"], nil
+func ArrayOf[T any](a AnyArray) (Array[T], error) {
+	result := make(Array[T], len(a))
+	for i, v := range a {
+		converted, ok := v.(T)
+		if !ok {
+			var zero T
+			return nil, fmt.Errorf("%w: element %d is %T, not %T", ErrTypeMismatch, i, v, zero)
+		}
+		result[i] = converted
+	}
+	return result, nil
+}
+
+// ToStrings converts the AnyArray to an Array[String]. Elements of type string are accepted as well.
+// Example: AnyArray{"a", String("b")}.ToStrings() -> ["a", "b"], nil
+func (a AnyArray) ToStrings() (Array[String], error) {
+	return ArrayOf[String](a.normalize(func(v any) any {
+		if s, ok := v.(string); ok {
+			return String(s)
+		}
+		return v
+	}))
+}
+
+// ToIntegers converts the AnyArray to an Array[Integer]. Elements of type int are accepted as well.
+// Example: AnyArray{1, Integer(2)}.ToIntegers() -> [1, 2], nil
+func (a AnyArray) ToIntegers() (Array[Integer], error) {
+	return ArrayOf[Integer](a.normalize(func(v any) any {
+		if i, ok := v.(int); ok {
+			return Integer(i)
+		}
+		return v
+	}))
+}
+
+// ToFloats converts the AnyArray to an Array[Float]. Elements of type float64 are accepted as well.
+// Example: AnyArray{1.5, Float(2.5)}.ToFloats() -> [1.5, 2.5], nil
+func (a AnyArray) ToFloats() (Array[Float], error) {
+	return ArrayOf[Float](a.normalize(func(v any) any {
+		if f, ok := v.(float64); ok {
+			return Float(f)
+		}
+		return v
+	}))
+}
+
+// ToBooleans converts the AnyArray to an Array[Boolean]. Elements of type bool are accepted as well.
+// Example: AnyArray{true, Boolean(false)}.ToBooleans() -> [true, false], nil
+func (a AnyArray) ToBooleans() (Array[Boolean], error) {
+	return ArrayOf[Boolean](a.normalize(func(v any) any {
+		if b, ok := v.(bool); ok {
+			return Boolean(b)
+		}
+		return v
+	}))
+}
+
+func (a AnyArray) normalize(fn func(any) any) AnyArray {
+	result := make(AnyArray, len(a))
+	for i, v := range a {
+		result[i] = fn(v)
+	}
+	return result
+}
+
+// Count returns the count of elements in the AnyArray based on the provided argument:
+// - If nil is given, it returns the total number of elements.
+// - If a predicate func(any) bool is given, it counts elements for which it returns true.
+// - Otherwise it counts elements equal to the argument.
+// Example: AnyArray{1, "a", 1}.Count(1) -> 2
+func (a AnyArray) Count(arg any) Integer {
+	predicate, ok := arg.(func(any) bool)
+	switch {
+	case arg == nil:
+		return Integer(len(a))
+	case !ok:
+		predicate = func(v any) bool { return equal(v, arg) }
+	}
+
+	tot := 0
+	for _, v := range a {
+		if predicate(v) {
+			tot++
+		}
+	}
+	return Integer(tot)
+}
+
+// Map applies the given function to each element and returns a new AnyArray.
+// Example: AnyArray{1, 2}.Map(func(v any) any { return v.(int) * 2 }) -> [2, 4]
+func (a AnyArray) Map(fn func(any) any) AnyArray {
+	return AnyArray(Array[any](a).Map(fn))
+}
+
+// Select returns a new AnyArray containing elements for which the predicate returns true.
+// Example: AnyArray{1, "a"}.Select(func(v any) bool { _, ok := v.(int); return ok }) -> [1]
+func (a AnyArray) Select(predicate func(any) bool) AnyArray {
+	return AnyArray(Array[any](a).Select(predicate))
+}
+
+// Reject returns a new AnyArray containing elements for which the predicate returns false.
+// Example: AnyArray{1, "a"}.Reject(func(v any) bool { _, ok := v.(int); return ok }) -> ["a"]
+func (a AnyArray) Reject(predicate func(any) bool) AnyArray {
+	return AnyArray(Array[any](a).Reject(predicate))
+}
+
+// Find returns a pointer to the first element for which the predicate returns true, or nil if none found.
+// A matched nil element is returned as a pointer to nil, unlike no match.
+// Example: AnyArray{1, "a"}.Find(func(v any) bool { _, ok := v.(string); return ok }) -> "a"
+func (a AnyArray) Find(predicate func(any) bool) *any {
+	return Array[any](a).Find(predicate)
+}
+
+// Any returns true if any element satisfies the predicate.
+func (a AnyArray) Any(predicate func(any) bool) Boolean {
+	return Array[any](a).Any(predicate)
+}
+
+// All returns true if all elements satisfy the predicate.
+func (a AnyArray) All(predicate func(any) bool) Boolean {
+	return Array[any](a).All(predicate)
+}
+
+// None returns true if no elements satisfy the predicate.
+func (a AnyArray) None(predicate func(any) bool) Boolean {
+	return Array[any](a).None(predicate)
+}
+
+// First returns the first element of the AnyArray, or nil if empty.
+// Example: AnyArray{"a", 1}.First() -> "a"
+func (a AnyArray) First() any {
+	if len(a) == 0 {
+		return nil
+	}
+	return a[0]
+}
+
+// Last returns the last element of the AnyArray, or nil if empty.
+// Example: AnyArray{"a", 1}.Last() -> 1
+func (a AnyArray) Last() any {
+	if len(a) == 0 {
+		return nil
+	}
+	return a[len(a)-1]
+}
+
+// Uniq returns a new AnyArray with duplicate elements removed.
+// Example: AnyArray{1, "a", 1}.Uniq() -> [1, "a"]
+func (a AnyArray) Uniq() AnyArray {
+	return AnyArray(Array[any](a).Uniq())
+}
+
+// Compact returns a new AnyArray with nil elements removed.
+// Unlike Array.Compact, zero values such as 0 or "" are kept, matching Ruby's compact.
+// Example: AnyArray{1, nil, 0, nil}.Compact() -> [1, 0]
+func (a AnyArray) Compact() AnyArray {
+	return a.Reject(isNil)
+}
+
+// Each applies the given function to each element.
+func (a AnyArray) Each(fn func(any)) {
+	Array[any](a).Each(fn)
+}
+
+// EachWithIndex applies the given function to each element with its index.
+func (a AnyArray) EachWithIndex(fn func(any, Integer)) {
+	Array[any](a).EachWithIndex(fn)
+}
+
+// Reverse returns a new AnyArray with elements in reverse order.
+func (a AnyArray) Reverse() AnyArray {
+	return AnyArray(Array[any](a).Reverse())
+}
+
+// Join concatenates all elements into a single String with the given separator.
+// Nested arrays are joined recursively, as in Ruby.
+// Example: AnyArray{1, "a", AnyArray{2, 3}}.Join("-") -> "1-a-2-3"
+func (a AnyArray) Join(separator String) String {
+	return Array[any](a.Flatten(-1)).Join(separator)
+}
+
+// Flatten returns a new AnyArray with nested arrays (AnyArray, Array, or any Go slice)
+// expanded in place up to the given depth. A negative depth flattens completely.
+// Example: AnyArray{1, AnyArray{2, AnyArray{3}}}.Flatten(1) -> [1, 2, [3]]
+func (a AnyArray) Flatten(depth Integer) AnyArray {
+	result := make(AnyArray, 0, len(a))
+	for _, v := range a {
+		if depth == 0 {
+			result = append(result, v)
+			continue
+		}
+		nested, ok := toAnyArray(v)
+		if !ok {
+			result = append(result, v)
+			continue
+		}
+		result = append(result, nested.Flatten(depth-1)...)
+	}
+	return result
+}
+
+// Take returns the first n elements of the AnyArray.
+func (a AnyArray) Take(n Integer) AnyArray {
+	return AnyArray(Array[any](a).Take(n))
+}
+
+// Drop returns the AnyArray without the first n elements.
+func (a AnyArray) Drop(n Integer) AnyArray {
+	return AnyArray(Array[any](a).Drop(n))
+}
+
+// IsEmpty checks if the AnyArray is empty.
+func (a AnyArray) IsEmpty() Boolean {
+	return Boolean(len(a) == 0)
+}
+
+// Length returns the length of the AnyArray.
+func (a AnyArray) Length() Integer {
+	return Integer(len(a))
+}
+
+// Size is an alias for Length.
+func (a AnyArray) Size() Integer {
+	return a.Length()
+}
+
+// Push appends an element to the end of the AnyArray.
+func (a AnyArray) Push(element any) AnyArray {
+	return AnyArray(Array[any](a).Push(element))
+}
+
+// Pop removes and returns the last element from the AnyArray.
+func (a AnyArray) Pop() (any, AnyArray) {
+	last, rest := Array[any](a).Pop()
+	return last, AnyArray(rest)
+}
+
+// Shift removes and returns the first element from the AnyArray.
+func (a AnyArray) Shift() (any, AnyArray) {
+	first, rest := Array[any](a).Shift()
+	return first, AnyArray(rest)
+}
+
+// Unshift prepends an element to the beginning of the AnyArray.
+func (a AnyArray) Unshift(element any) AnyArray {
+	return AnyArray(Array[any](a).Unshift(element))
+}
+
+// Include checks if the AnyArray contains the given element.
+// Example: AnyArray{1, "a"}.Include("a") -> true
+func (a AnyArray) Include(element any) Boolean {
+	return Array[any](a).Include(element)
+}
+
+// Index returns the index of the first occurrence of the given element, or -1 if not found.
+func (a AnyArray) Index(element any) Integer {
+	return Array[any](a).Index(element)
+}
+
+// RIndex returns the index of the last occurrence of the given element, or -1 if not found.
+func (a AnyArray) RIndex(element any) Integer {
+	return Array[any](a).RIndex(element)
+}
+
+// Rotate rotates the AnyArray by the given number of positions.
+func (a AnyArray) Rotate(positions Integer) AnyArray {
+	return AnyArray(Array[any](a).Rotate(positions))
+}
+
+// Chunk splits the AnyArray into chunks of the specified size.
+func (a AnyArray) Chunk(size Integer) []AnyArray {
+	chunks := Array[any](a).Chunk(size)
+	result := make([]AnyArray, len(chunks))
+	for i, c := range chunks {
+		result[i] = AnyArray(c)
+	}
+	return result
+}
+
+// isNil reports whether v is nil or a nil pointer, slice, map, channel, func or interface.
+func isNil(v any) bool {
+	if v == nil {
+		return true
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Map, reflect.Chan, reflect.Func, reflect.Interface:
+		return rv.IsNil()
+	default:
+		return false
+	}
+}
+
+// toAnyArray returns v as an AnyArray if it is a slice or array of any element type.
+func toAnyArray(v any) (AnyArray, bool) {
+	switch nested := v.(type) {
+	case AnyArray:
+		return nested, true
+	case []any:
+		return AnyArray(nested), true
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil, false
+	}
+	result := make(AnyArray, rv.Len())
+	for i := range result {
+		result[i] = rv.Index(i).Interface()
+	}
+	return result, true
+}
@@ -0,0 +1,185 @@
+package rb
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestAnyArray_Count(t *testing.T) {
+	array := AnyArray{1, "a", 1, nil}
+
+	tests := []struct {
+		arg      any
+		expected Integer
+	}{
+		{nil, Integer(4)},
+		{1, Integer(2)},
+		{"b", Integer(0)},
+		{func(v any) bool { _, ok := v.(string); return ok }, Integer(1)},
+	}
+
+	for _, test := range tests {
+		result := array.Count(test.arg)
+		if result != test.expected {
+			t.Errorf("Count() with arg %v expected %d, got %d", test.arg, test.expected, result)
+		}
+	}
+}
+
+func TestAnyArray_MapSelect(t *testing.T) {
+	array := AnyArray{1, "a", 2}
+
+	ints := array.Select(func(v any) bool {
+		_, ok := v.(int)
+		return ok
+	})
+	doubled := ints.Map(func(v any) any { return v.(int) * 2 })
+
+	if doubled.Join(",") != "2,4" {
+		t.Errorf("Select().Map() expected 2,4, got %s", doubled.Join(","))
+	}
+
+	rejected := array.Reject(func(v any) bool {
+		_, ok := v.(int)
+		return ok
+	})
+	if len(rejected) != 1 || rejected[0] != "a" {
+		t.Errorf("Reject() expected [a], got %v", rejected)
+	}
+}
+
+func TestAnyArray_Find(t *testing.T) {
+	array := AnyArray{1, nil, "a", "b"}
+
+	result := array.Find(func(v any) bool {
+		_, ok := v.(string)
+		return ok
+	})
+	if result == nil || *result != "a" {
+		t.Errorf("Find() expected a, got %v", result)
+	}
+
+	result = array.Find(func(v any) bool { return v == nil })
+	if result == nil || *result != nil {
+		t.Errorf("Find() expected a pointer to the nil element, got %v", result)
+	}
+
+	result = array.Find(func(v any) bool { return false })
+	if result != nil {
+		t.Errorf("Find() expected nil, got %v", result)
+	}
+}
+
+func TestAnyArray_Compact(t *testing.T) {
+	var nilPtr *int
+	array := AnyArray{1, nil, 0, "", nilPtr, "a"}
+	result := array.Compact()
+
+	expected := AnyArray{1, 0, "", "a"}
+	if len(result) != len(expected) {
+		t.Fatalf("Compact() expected length %d, got %d", len(expected), len(result))
+	}
+	for i, val := range result {
+		if val != expected[i] {
+			t.Errorf("Compact() at index %d expected %v, got %v", i, expected[i], val)
+		}
+	}
+}
+
+func TestAnyArray_Flatten(t *testing.T) {
+	array := AnyArray{1, AnyArray{2, []any{3, Array[Integer]{4, 5}}}, "a"}
+
+	tests := []struct {
+		depth    Integer
+		expected Integer
+	}{
+		{0, 3},
+		{1, 4},
+		{2, 5},
+		{-1, 6},
+	}
+
+	for _, test := range tests {
+		result := array.Flatten(test.depth)
+		if result.Length() != test.expected {
+			t.Errorf("Flatten(%d) expected length %d, got %d (%v)", test.depth, test.expected, result.Length(), result)
+		}
+	}
+
+	if joined := array.Join("-"); joined != "1-2-3-4-5-a" {
+		t.Errorf("Join() expected 1-2-3-4-5-a, got %s", joined)
+	}
+}
+
+func TestAnyArray_Query(t *testing.T) {
+	array := AnyArray{"a", 1, "a"}
+
+	if array.First() != "a" || array.Last() != "a" {
+		t.Errorf("First()/Last() returned unexpected values")
+	}
+	if (AnyArray{}).First() != nil {
+		t.Error("First() on empty AnyArray should return nil")
+	}
+	if !array.Include(1) || array.Include(2) {
+		t.Error("Include() returned unexpected result")
+	}
+	if array.Index("a") != 0 || array.RIndex("a") != 2 {
+		t.Error("Index()/RIndex() returned unexpected result")
+	}
+	if uniq := array.Uniq(); len(uniq) != 2 {
+		t.Errorf("Uniq() expected length 2, got %d", len(uniq))
+	}
+	if !array.Any(func(v any) bool { return v == 1 }) {
+		t.Error("Any() should return true")
+	}
+}
+
+func TestArrayOf(t *testing.T) {
+	result, err := ArrayOf[String](AnyArray{String("a"), String("b")})
+	if err != nil {
+		t.Fatalf("ArrayOf() unexpected error: %v", err)
+	}
+	if result.Join(",") != "a,b" {
+		t.Errorf("ArrayOf() expected a,b, got %s", result.Join(","))
+	}
+
+	_, err = ArrayOf[String](AnyArray{String("a"), 1})
+	if !errors.Is(err, ErrTypeMismatch) {
+		t.Errorf("ArrayOf() expected ErrTypeMismatch, got %v", err)
+	}
+}
+
+func TestAnyArray_TypedConversions(t *testing.T) {
+	strs, err := AnyArray{"a", String("b")}.ToStrings()
+	if err != nil || strs.Join(",") != "a,b" {
+		t.Errorf("ToStrings() expected a,b, got %v (%v)", strs, err)
+	}
+
+	ints, err := AnyArray{1, Integer(2)}.ToIntegers()
+	if err != nil || ints.Join(",") != "1,2" {
+		t.Errorf("ToIntegers() expected 1,2, got %v (%v)", ints, err)
+	}
+
+	floats, err := AnyArray{1.5, Float(2.5)}.ToFloats()
+	if err != nil || floats.Join(",") != "1.5,2.5" {
+		t.Errorf("ToFloats() expected 1.5,2.5, got %v (%v)", floats, err)
+	}
+
+	bools, err := AnyArray{true, Boolean(false)}.ToBooleans()
+	if err != nil || bools.Join(",") != "true,false" {
+		t.Errorf("ToBooleans() expected true,false, got %v (%v)", bools, err)
+	}
+
+	if _, err := (AnyArray{1, "a"}).ToIntegers(); !errors.Is(err, ErrTypeMismatch) {
+		t.Errorf("ToIntegers() expected ErrTypeMismatch, got %v", err)
+	}
+}
+
+func TestHash_KeysToTypedArray(t *testing.T) {
+	hash := Hash[String, Integer]{"a": 1}
+
+	keys, err := hash.Keys().ToAnyArray().ToStrings()
+	if err != nil || len(keys) != 1 || keys[0] != "a" {
+		t.Errorf("Keys().ToAnyArray().ToStrings() expected [a], got %v (%v)", keys, err)
+	}
+}
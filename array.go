@@ -2,21 +2,27 @@
 package rb
 
 import (
+	"cmp"
+	"errors"
 	"fmt"
+	"iter"
+	"reflect"
 	"sort"
 	"strings"
 )
 
 // Array is a generic type to emulate Ruby-like arrays.
-// Supports elements of type String, Integer, Boolean, or Float.
-type Array[T String | Integer | Boolean | Float] []T
+// Supports elements of any type. Equality-dependent methods such as Include,
+// Index, Count and Uniq use == for comparable elements and fall back to
+// reflect.DeepEqual for slices, maps and other non-comparable values.
+type Array[T any] []T
 
-// AnyArray is an array type that supports any values.
-type AnyArray []any
+// ErrRaggedArray is returned by Transpose when rows have different lengths.
+var ErrRaggedArray = errors.New("rb: element size differs")
 
 // CountArrayArg defines the valid argument types for the Count method.
 // It can be an element of the Array's type or a predicate function that returns a Boolean.
-type CountArrayArg[T String | Integer | Boolean | Float] any
+type CountArrayArg[T any] any
 
 // Count returns the count of elements in the Array based on the provided argument:
 // - If no arguments are given, it returns the total number of elements in the Array.
@@ -32,18 +38,18 @@ func (a Array[T]) Count(arg CountArrayArg[T]) Integer {
 	switch needle := arg.(type) {
 	case nil:
 		return Integer(len(a))
-	case T:
+	case func(T) bool:
 		tot := 0
 		for _, v := range a {
-			if v == needle {
+			if needle(v) {
 				tot++
 			}
 		}
 		return Integer(tot)
-	case func(T) bool:
-		tot := 0
+	case T:
+		eq, tot := equalFor[T](), 0
 		for _, v := range a {
-			if needle(v) {
+			if eq(v, needle) {
 				tot++
 			}
 		}
@@ -180,12 +186,17 @@ func (a Array[T]) Last() *T {
 // Uniq returns a new Array with duplicate elements removed.
 // Example: Array[String]{"a", "b", "a", "c"}.Uniq() -> ["a", "b", "c"]
 func (a Array[T]) Uniq() Array[T] {
-	seen := make(map[any]bool)
+	return a.UniqBy(func(v T) any { return v })
+}
+
+// UniqBy returns a new Array with elements removed whose key, as returned by fn, duplicates an earlier one.
+// Example: Array[String]{"a", "B", "A"}.UniqBy(func(s String) any { return s.Downcase() }) -> ["a", "B"]
+func (a Array[T]) UniqBy(fn func(T) any) Array[T] {
+	seen := newSeenSet()
 	result := make([]T, 0)
 
 	for _, v := range a {
-		if !seen[v] {
-			seen[v] = true
+		if seen.add(fn(v)) {
 			result = append(result, v)
 		}
 	}
@@ -198,9 +209,7 @@ func (a Array[T]) Uniq() Array[T] {
 func (a Array[T]) Compact() Array[T] {
 	result := make([]T, 0)
 	for _, v := range a {
-		// Check if the value is a zero value
-		var zero T
-		if v != zero {
+		if !isZero(v) {
 			result = append(result, v)
 		}
 	}
@@ -233,40 +242,112 @@ func (a Array[T]) Reverse() Array[T] {
 	return result
 }
 
-// Sort sorts the Array in ascending order (for comparable types).
-// Note: This is a simplified implementation that may not work for all types.
+// Sort returns a new Array sorted in ascending order.
+// Elements are ordered by their underlying kind: strings lexically, integers and floats
+// numerically, and booleans with false before true. Elements without a natural ordering
+// keep their relative order; use SortWith for those.
 // Example: Array[Integer]{3, 1, 2}.Sort() -> [1, 2, 3]
 func (a Array[T]) Sort() Array[T] {
+	return a.SortWith(func(x, y T) int {
+		return compareValues(x, y)
+	})
+}
+
+// EnforceSort sorts the Array in place and returns it.
+func (a *Array[T]) EnforceSort() Array[T] {
+	return a.EnforceSortWith(func(x, y T) int {
+		return compareValues(x, y)
+	})
+}
+
+// SortBy returns a new Array sorted by the key returned from fn. The sort is stable.
+// Example: Array[String]{"ccc", "a", "bb"}.SortBy(func(s String) any { return s.Length() }) -> ["a", "bb", "ccc"]
+func (a Array[T]) SortBy(fn func(T) any) Array[T] {
 	result := make(Array[T], len(a))
 	copy(result, a)
+	result.EnforceSortBy(fn)
+	return result
+}
 
-	// Use Go's sort package for proper sorting
-	sort.Slice(result, func(i, j int) bool {
-		// Convert to comparable types for sorting
-		switch v1 := any(result[i]).(type) {
-		case string:
-			if v2, ok := any(result[j]).(string); ok {
-				return v1 < v2
-			}
-		case int:
-			if v2, ok := any(result[j]).(int); ok {
-				return v1 < v2
-			}
-		case float64:
-			if v2, ok := any(result[j]).(float64); ok {
-				return v1 < v2
-			}
-		case bool:
-			if v2, ok := any(result[j]).(bool); ok {
-				return !v1 && v2 // false < true
-			}
-		}
-		return false
-	})
+// EnforceSortBy sorts the Array in place by the key returned from fn and returns it.
+func (a *Array[T]) EnforceSortBy(fn func(T) any) Array[T] {
+	keys := make([]any, len(*a))
+	for i, v := range *a {
+		keys[i] = fn(v)
+	}
 
+	sort.Stable(keyedSort[T]{values: *a, keys: keys})
+	return *a
+}
+
+// SortWith returns a new Array sorted using a spaceship-style comparator that returns
+// a negative number, zero, or a positive number when x is less than, equal to, or greater than y.
+// The sort is stable.
+// Example: Array[Integer]{1, 3, 2}.SortWith(func(x, y Integer) int { return int(y - x) }) -> [3, 2, 1]
+func (a Array[T]) SortWith(comparator func(x, y T) int) Array[T] {
+	result := make(Array[T], len(a))
+	copy(result, a)
+	result.EnforceSortWith(comparator)
 	return result
 }
 
+// EnforceSortWith sorts the Array in place using the given comparator and returns it.
+func (a *Array[T]) EnforceSortWith(comparator func(x, y T) int) Array[T] {
+	values := *a
+	sort.SliceStable(values, func(i, j int) bool {
+		return comparator(values[i], values[j]) < 0
+	})
+	return values
+}
+
+// Max returns the largest element of the Array using the same ordering as Sort, or nil if empty.
+// Example: Array[Integer]{3, 1, 2}.Max() -> 3
+func (a Array[T]) Max() *T {
+	return a.MaxBy(func(v T) any { return v })
+}
+
+// Min returns the smallest element of the Array using the same ordering as Sort, or nil if empty.
+// Example: Array[Integer]{3, 1, 2}.Min() -> 1
+func (a Array[T]) Min() *T {
+	return a.MinBy(func(v T) any { return v })
+}
+
+// MinMax returns the smallest and largest elements of the Array, or nil, nil if empty.
+// Example: Array[Integer]{3, 1, 2}.MinMax() -> 1, 3
+func (a Array[T]) MinMax() (minVal, maxVal *T) {
+	return a.Min(), a.Max()
+}
+
+// MaxBy returns the element for which fn returns the largest key, or nil if empty.
+// When several elements share the largest key, the first one is returned.
+// Example: Array[String]{"a", "ccc", "bb"}.MaxBy(func(s String) any { return s.Length() }) -> "ccc"
+func (a Array[T]) MaxBy(fn func(T) any) *T {
+	return a.extremeBy(fn, 1)
+}
+
+// MinBy returns the element for which fn returns the smallest key, or nil if empty.
+// When several elements share the smallest key, the first one is returned.
+// Example: Array[String]{"ccc", "a", "bb"}.MinBy(func(s String) any { return s.Length() }) -> "a"
+func (a Array[T]) MinBy(fn func(T) any) *T {
+	return a.extremeBy(fn, -1)
+}
+
+func (a Array[T]) extremeBy(fn func(T) any, sign int) *T {
+	if len(a) == 0 {
+		return nil
+	}
+
+	best := 0
+	bestKey := fn(a[0])
+	for i := 1; i < len(a); i++ {
+		key := fn(a[i])
+		if compareValues(key, bestKey)*sign > 0 {
+			best, bestKey = i, key
+		}
+	}
+	return &a[best]
+}
+
 // Join concatenates all elements into a single String with the given separator.
 // Note: This method only works with types that have a ToS() method.
 // Example: Array[String]{"a", "b", "c"}.Join("-") -> "a-b-c"
@@ -386,8 +467,14 @@ func (a Array[T]) Unshift(element T) Array[T] {
 // Include checks if the Array contains the given element.
 // Example: Array[String]{"a", "b"}.Include("a") -> true
 func (a Array[T]) Include(element T) Boolean {
+	return a.IncludeFunc(element, equalFor[T]())
+}
+
+// IncludeFunc checks if the Array contains an element equal to the given one according to eq.
+// Example: Array[User]{u1, u2}.IncludeFunc(u, func(a, b User) bool { return a.ID == b.ID }) -> true
+func (a Array[T]) IncludeFunc(element T, eq func(a, b T) bool) Boolean {
 	for _, v := range a {
-		if v == element {
+		if eq(v, element) {
 			return true
 		}
 	}
@@ -397,8 +484,15 @@ func (a Array[T]) Include(element T) Boolean {
 // Index returns the index of the first occurrence of the given element, or -1 if not found.
 // Example: Array[String]{"a", "b", "a"}.Index("a") -> 0
 func (a Array[T]) Index(element T) Integer {
+	eq := equalFor[T]()
+	return a.FindIndex(func(v T) bool { return eq(v, element) })
+}
+
+// FindIndex returns the index of the first element for which the predicate returns true, or -1 if none found.
+// Example: Array[Integer]{1, 2, 3}.FindIndex(func(i Integer) bool { return i > 1 }) -> 1
+func (a Array[T]) FindIndex(predicate func(T) bool) Integer {
 	for i, v := range a {
-		if v == element {
+		if predicate(v) {
 			return Integer(i)
 		}
 	}
@@ -408,8 +502,9 @@ func (a Array[T]) Index(element T) Integer {
 // RIndex returns the index of the last occurrence of the given element, or -1 if not found.
 // Example: Array[String]{"a", "b", "a"}.RIndex("a") -> 2
 func (a Array[T]) RIndex(element T) Integer {
+	eq := equalFor[T]()
 	for i := len(a) - 1; i >= 0; i-- {
-		if a[i] == element {
+		if eq(a[i], element) {
 			return Integer(i)
 		}
 	}
@@ -499,6 +594,174 @@ func (a Array[T]) Chunk(size Integer) []Array[T] {
 	return chunks
 }
 
+// Union returns a new Array with the unique elements of the Array and the given Arrays,
+// in order of first appearance, like Ruby's | and union.
+// Example: Array[Integer]{1, 2, 2}.Union(Array[Integer]{2, 3}) -> [1, 2, 3]
+func (a Array[T]) Union(others ...Array[T]) Array[T] {
+	seen := newSeenSetFor[T]()
+	result := make(Array[T], 0, len(a))
+	for _, list := range append([]Array[T]{a}, others...) {
+		for _, v := range list {
+			if seen.add(v) {
+				result = append(result, v)
+			}
+		}
+	}
+	return result
+}
+
+// Intersection returns a new Array with the unique elements of the Array that are present
+// in all the given Arrays, in the receiver's order, like Ruby's & and intersection.
+// Example: Array[Integer]{1, 1, 2, 3}.Intersection(Array[Integer]{3, 1}) -> [1, 3]
+func (a Array[T]) Intersection(others ...Array[T]) Array[T] {
+	lookups := make([]*seenSet, len(others))
+	for i, other := range others {
+		lookups[i] = lookupOf(other)
+	}
+
+	seen := newSeenSetFor[T]()
+	result := make(Array[T], 0)
+	for _, v := range a {
+		if !seen.add(v) {
+			continue
+		}
+		inAll := true
+		for _, lookup := range lookups {
+			if !lookup.has(v) {
+				inAll = false
+				break
+			}
+		}
+		if inAll {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// Difference returns a new Array with every occurrence of elements found in any of the given
+// Arrays removed, like Ruby's - and difference. Remaining duplicates are kept.
+// Example: Array[Integer]{1, 1, 2, 3}.Difference(Array[Integer]{2}) -> [1, 1, 3]
+func (a Array[T]) Difference(others ...Array[T]) Array[T] {
+	lookup := lookupOf(others...)
+	result := make(Array[T], 0, len(a))
+	for _, v := range a {
+		if !lookup.has(v) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// IsIntersect checks if the Array has at least one element in common with other, like Ruby's intersect?.
+// Example: Array[Integer]{1, 2}.IsIntersect(Array[Integer]{2, 3}) -> true
+func (a Array[T]) IsIntersect(other Array[T]) Boolean {
+	lookup := lookupOf(other)
+	for _, v := range a {
+		if lookup.has(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// Zip merges the Array with the given Arrays element-wise, returning one Array per element of the receiver.
+// Missing elements in shorter Arrays are filled with the zero value.
+// Example: Array[Integer]{1, 2}.Zip(Array[Integer]{3, 4}, Array[Integer]{5}) -> [[1, 3, 5], [2, 4, 0]]
+func (a Array[T]) Zip(others ...Array[T]) []Array[T] {
+	result := make([]Array[T], len(a))
+	for i, v := range a {
+		row := make(Array[T], len(others)+1)
+		row[0] = v
+		for j, other := range others {
+			if i < len(other) {
+				row[j+1] = other[i]
+			}
+		}
+		result[i] = row
+	}
+	return result
+}
+
+// Product returns the cartesian product of the Array and the given Arrays.
+// Example: Array[Integer]{1, 2}.Product(Array[Integer]{3, 4}) -> [[1, 3], [1, 4], [2, 3], [2, 4]]
+func (a Array[T]) Product(others ...Array[T]) []Array[T] {
+	result := []Array[T]{{}}
+	for _, list := range append([]Array[T]{a}, others...) {
+		next := make([]Array[T], 0, len(result)*len(list))
+		for _, prefix := range result {
+			for _, v := range list {
+				next = append(next, prefix.Push(v))
+			}
+		}
+		result = next
+	}
+	return result
+}
+
+// Flatten returns an AnyArray with nested arrays expanded up to the given depth.
+// A negative depth flattens completely. Use the package-level Flatten to keep the element type.
+// Example: Array[Array[Integer]]{{1, 2}, {3}}.Flatten(-1) -> [1, 2, 3]
+func (a Array[T]) Flatten(depth Integer) AnyArray {
+	return a.ToAnyArray().Flatten(depth)
+}
+
+// ToAnyArray converts the Array to an AnyArray.
+// Example: Array[Integer]{1, 2}.ToAnyArray() -> [1, 2]
+func (a Array[T]) ToAnyArray() AnyArray {
+	result := make(AnyArray, len(a))
+	for i, v := range a {
+		result[i] = v
+	}
+	return result
+}
+
+// Partition splits the Array into elements for which the predicate returns true and those for which it returns false.
+// Example: Array[Integer]{1, 2, 3, 4}.Partition(func(i Integer) bool { return i%2 == 0 }) -> [2, 4], [1, 3]
+func (a Array[T]) Partition(predicate func(T) bool) (selected, rejected Array[T]) {
+	selected, rejected = Array[T]{}, Array[T]{}
+	for _, v := range a {
+		if predicate(v) {
+			selected = append(selected, v)
+		} else {
+			rejected = append(rejected, v)
+		}
+	}
+	return selected, rejected
+}
+
+// ChunkWhile splits the Array between adjacent elements for which the predicate returns false.
+// Example: Array[Integer]{1, 2, 4, 5, 7}.ChunkWhile(func(i, j Integer) bool { return j == i+1 }) -> [[1, 2], [4, 5], [7]]
+func (a Array[T]) ChunkWhile(predicate func(prev, next T) bool) []Array[T] {
+	return a.SliceWhen(func(prev, next T) bool { return !predicate(prev, next) })
+}
+
+// SliceWhen splits the Array between adjacent elements for which the predicate returns true.
+// Example: Array[Integer]{1, 2, 4, 5, 7}.SliceWhen(func(i, j Integer) bool { return j != i+1 }) -> [[1, 2], [4, 5], [7]]
+func (a Array[T]) SliceWhen(predicate func(prev, next T) bool) []Array[T] {
+	chunks := make([]Array[T], 0)
+	start := 0
+	for i := 1; i <= len(a); i++ {
+		if i == len(a) || predicate(a[i-1], a[i]) {
+			chunks = append(chunks, Array[T](a[start:i]))
+			start = i
+		}
+	}
+	return chunks
+}
+
+// SliceBefore splits the Array before each element for which the predicate returns true.
+// Example: Array[String]{"#a", "1", "#b", "2"}.SliceBefore(func(s String) bool { return bool(s.StartWith("#")) }) -> [["#a", "1"], ["#b", "2"]]
+func (a Array[T]) SliceBefore(predicate func(T) bool) []Array[T] {
+	return a.SliceWhen(func(_, next T) bool { return predicate(next) })
+}
+
+// SliceAfter splits the Array after each element for which the predicate returns true.
+// Example: Array[String]{"a", "b;", "c;", "d"}.SliceAfter(func(s String) bool { return bool(s.EndWith(";")) }) -> [["a", "b;"], ["c;"], ["d"]]
+func (a Array[T]) SliceAfter(predicate func(T) bool) []Array[T] {
+	return a.SliceWhen(func(prev, _ T) bool { return predicate(prev) })
+}
+
 // Cycle repeats the Array elements the specified number of times.
 // Example: Array[Integer]{1, 2}.Cycle(3) -> [1, 2, 1, 2, 1, 2]
 func (a Array[T]) Cycle(times Integer) Array[T] {
@@ -512,3 +775,630 @@ func (a Array[T]) Cycle(times Integer) Array[T] {
 	}
 	return result
 }
+
+// Seq returns an iterator over the elements of the Array, for use with range-over-func
+// and the standard slices package. Unlike Each, the loop can break or return early.
+// Example: for v := range Array[Integer]{1, 2}.Seq() { fmt.Println(v) }
+func (a Array[T]) Seq() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range a {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// TryMap is like Map for callbacks that can fail. By default it stops at the first error
+// and returns it; pass CollectErrors to process every element and return all errors joined.
+// The returned Array is nil whenever the error is not.
+// Example: Array[String]{"1", "2"}.TryMap(func(s String) (String, error) { return s + "!", nil }) -> ["1!", "2!"], nil
+func (a Array[T]) TryMap(fn func(T) (T, error), mode ...ErrorMode) (Array[T], error) {
+	return TryMapTo(a, fn, mode...)
+}
+
+// TryEach is like Each for callbacks that can fail, with errors handled as for TryMap.
+// Example: Array[String]{"a"}.TryEach(func(s String) error { return save(s) })
+func (a Array[T]) TryEach(fn func(T) error, mode ...ErrorMode) error {
+	return tryEachSeq(a.Seq(), fn, mode)
+}
+
+// TrySelect is like Select for predicates that can fail, with errors handled as for TryMap.
+// Example: Array[Integer]{1, 2}.TrySelect(func(i Integer) (bool, error) { return i > 1, nil }) -> [2], nil
+func (a Array[T]) TrySelect(predicate func(T) (bool, error), mode ...ErrorMode) (Array[T], error) {
+	errs := newErrorCollector(mode)
+	result := make(Array[T], 0)
+	for _, v := range a {
+		keep, err := predicate(v)
+		if errs.add(err) {
+			break
+		}
+		if err == nil && keep {
+			result = append(result, v)
+		}
+	}
+
+	if err := errs.err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// TryReject is like Reject for predicates that can fail, with errors handled as for TryMap.
+// Example: Array[Integer]{1, 2}.TryReject(func(i Integer) (bool, error) { return i > 1, nil }) -> [1], nil
+func (a Array[T]) TryReject(predicate func(T) (bool, error), mode ...ErrorMode) (Array[T], error) {
+	return a.TrySelect(func(v T) (bool, error) {
+		reject, err := predicate(v)
+		return !reject, err
+	}, mode...)
+}
+
+// TryInject is like InjectWith for callbacks that can fail, with errors handled as for TryMap.
+// With CollectErrors, a failing step leaves the accumulator unchanged.
+// Example: Array[Integer]{1, 2}.TryInject(0, func(acc, i Integer) (Integer, error) { return acc + i, nil }) -> 3, nil
+func (a Array[T]) TryInject(initial T, fn func(acc, v T) (T, error), mode ...ErrorMode) (T, error) {
+	return TryReduce(a, initial, fn, mode...)
+}
+
+// Combination returns a lazy iterator over all combinations of n elements of the Array.
+// Each combination is yielded as a new Array; returning false from yield stops the iteration.
+// Example: Array[Integer]{1, 2, 3}.Combination(2) yields [1, 2], [1, 3], [2, 3]
+func (a Array[T]) Combination(n Integer) iter.Seq[Array[T]] {
+	return func(yield func(Array[T]) bool) {
+		a.eachIndexTuple(int(n), func(i int) int { return i + 1 }, false, yield)
+	}
+}
+
+// RepeatedCombination returns a lazy iterator over all combinations of n elements of the Array
+// where each element may be chosen more than once.
+// Example: Array[Integer]{1, 2}.RepeatedCombination(2) yields [1, 1], [1, 2], [2, 2]
+func (a Array[T]) RepeatedCombination(n Integer) iter.Seq[Array[T]] {
+	return func(yield func(Array[T]) bool) {
+		a.eachIndexTuple(int(n), func(i int) int { return i }, true, yield)
+	}
+}
+
+// Permutation returns a lazy iterator over all permutations of n elements of the Array.
+// Example: Array[Integer]{1, 2, 3}.Permutation(2) yields [1, 2], [1, 3], [2, 1], [2, 3], [3, 1], [3, 2]
+func (a Array[T]) Permutation(n Integer) iter.Seq[Array[T]] {
+	return func(yield func(Array[T]) bool) {
+		a.eachIndexTuple(int(n), func(int) int { return 0 }, false, yield)
+	}
+}
+
+// RepeatedPermutation returns a lazy iterator over all permutations of n elements of the Array
+// where each element may be chosen more than once.
+// Example: Array[Integer]{1, 2}.RepeatedPermutation(2) yields [1, 1], [1, 2], [2, 1], [2, 2]
+func (a Array[T]) RepeatedPermutation(n Integer) iter.Seq[Array[T]] {
+	return func(yield func(Array[T]) bool) {
+		a.eachIndexTuple(int(n), func(int) int { return 0 }, true, yield)
+	}
+}
+
+// EachCons returns a lazy iterator over each window of n consecutive elements.
+// Example: Array[Integer]{1, 2, 3, 4}.EachCons(2) yields [1, 2], [2, 3], [3, 4]
+func (a Array[T]) EachCons(n Integer) iter.Seq[Array[T]] {
+	return func(yield func(Array[T]) bool) {
+		if n <= 0 {
+			return
+		}
+		for i := 0; i+int(n) <= len(a); i++ {
+			if !yield(a[i : i+int(n)].Take(n)) {
+				return
+			}
+		}
+	}
+}
+
+// EachSlice returns a lazy iterator over consecutive slices of the given size,
+// with the same semantics as Chunk.
+// Example: Array[Integer]{1, 2, 3, 4, 5}.EachSlice(2) yields [1, 2], [3, 4], [5]
+func (a Array[T]) EachSlice(size Integer) iter.Seq[Array[T]] {
+	return func(yield func(Array[T]) bool) {
+		if size <= 0 {
+			return
+		}
+		for i := 0; i < len(a); i += int(size) {
+			if !yield(a[i:].Take(size)) {
+				return
+			}
+		}
+	}
+}
+
+// eachIndexTuple yields every tuple of n elements whose indices are generated depth-first.
+// nextStart returns the lowest index to consider at the next position given the index just
+// chosen; repeat allows an index to appear more than once in a tuple.
+func (a Array[T]) eachIndexTuple(n int, nextStart func(i int) int, repeat bool, yield func(Array[T]) bool) {
+	if n < 0 || (!repeat && n > len(a)) || (n > 0 && len(a) == 0) {
+		return
+	}
+
+	indices := make([]int, n)
+	used := make([]bool, len(a))
+
+	var walk func(pos, start int) bool
+	walk = func(pos, start int) bool {
+		if pos == n {
+			tuple := make(Array[T], n)
+			for i, idx := range indices {
+				tuple[i] = a[idx]
+			}
+			return yield(tuple)
+		}
+
+		for i := start; i < len(a); i++ {
+			if !repeat && used[i] {
+				continue
+			}
+			indices[pos] = i
+			used[i] = true
+			ok := walk(pos+1, nextStart(i))
+			used[i] = false
+			if !ok {
+				return false
+			}
+		}
+		return true
+	}
+
+	walk(0, 0)
+}
+
+// Reduce combines all elements by applying fn to an accumulator and each element in turn,
+// using the first element as the initial accumulator. Returns nil if the Array is empty.
+// Example: Array[Integer]{1, 2, 3}.Reduce(func(acc, i Integer) Integer { return acc + i }) -> 6
+func (a Array[T]) Reduce(fn func(acc, v T) T) *T {
+	if len(a) == 0 {
+		return nil
+	}
+
+	result := a[1:].ReduceWith(a[0], fn)
+	return &result
+}
+
+// Inject is an alias for Reduce.
+func (a Array[T]) Inject(fn func(acc, v T) T) *T {
+	return a.Reduce(fn)
+}
+
+// ReduceWith combines all elements by applying fn to an accumulator and each element in turn,
+// starting from the given initial value.
+// Example: Array[Integer]{1, 2, 3}.ReduceWith(10, func(acc, i Integer) Integer { return acc + i }) -> 16
+func (a Array[T]) ReduceWith(initial T, fn func(acc, v T) T) T {
+	return Reduce(a, initial, fn)
+}
+
+// InjectWith is an alias for ReduceWith.
+func (a Array[T]) InjectWith(initial T, fn func(acc, v T) T) T {
+	return a.ReduceWith(initial, fn)
+}
+
+// Reduce folds the Array into an accumulator of any type, starting from initial.
+// Example: Reduce(Array[String]{"a", "bb"}, Integer(0), func(n Integer, s String) Integer { return n + s.Length() }) -> 3
+func Reduce[T, A any](a Array[T], initial A, fn func(acc A, v T) A) A {
+	acc := initial
+	for _, v := range a {
+		acc = fn(acc, v)
+	}
+	return acc
+}
+
+// ArrayFrom collects the values of an iterator into a new Array.
+// Example: ArrayFrom(maps.Keys(map[String]int{"a": 1})) -> ["a"]
+func ArrayFrom[T any](seq iter.Seq[T]) Array[T] {
+	result := make(Array[T], 0)
+	for v := range seq {
+		result = append(result, v)
+	}
+	return result
+}
+
+// TryReduce is like Reduce for callbacks that can fail, with errors handled as for Array.TryInject.
+// On error the zero value of A is returned.
+// Example: TryReduce(Array[String]{"1", "2"}, Integer(0), func(n Integer, s String) (Integer, error) { return n + s.ToI(), nil }) -> 3, nil
+func TryReduce[T, A any](a Array[T], initial A, fn func(acc A, v T) (A, error), mode ...ErrorMode) (A, error) {
+	errs := newErrorCollector(mode)
+	acc := initial
+	for _, v := range a {
+		next, err := fn(acc, v)
+		if errs.add(err) {
+			break
+		}
+		if err == nil {
+			acc = next
+		}
+	}
+
+	if err := errs.err(); err != nil {
+		var zero A
+		return zero, err
+	}
+	return acc, nil
+}
+
+// TryMapTo is like MapTo for callbacks that can fail, with errors handled as for Array.TryMap.
+// Example: TryMapTo(Array[String]{"1", "x"}, parse) -> nil, error
+func TryMapTo[T, U any](a Array[T], fn func(T) (U, error), mode ...ErrorMode) (Array[U], error) {
+	errs := newErrorCollector(mode)
+	result := make(Array[U], len(a))
+	for i, v := range a {
+		mapped, err := fn(v)
+		if errs.add(err) {
+			break
+		}
+		result[i] = mapped
+	}
+
+	if err := errs.err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// MapTo applies fn to each element and returns a new Array of the results, which may be of a different type.
+// Example: MapTo(Array[String]{"a", "bb"}, String.Length) -> [1, 2]
+func MapTo[T, U any](a Array[T], fn func(T) U) Array[U] {
+	result := make(Array[U], len(a))
+	for i, v := range a {
+		result[i] = fn(v)
+	}
+	return result
+}
+
+// FlatMap applies fn to each element and concatenates the returned Arrays.
+// Example: FlatMap(Array[String]{"a b", "c"}, String.Words) -> ["a", "b", "c"]
+func FlatMap[T, U any](a Array[T], fn func(T) Array[U]) Array[U] {
+	result := make(Array[U], 0, len(a))
+	for _, v := range a {
+		result = append(result, fn(v)...)
+	}
+	return result
+}
+
+// FilterMap applies fn to each element and keeps the results for which fn returns true.
+// Example: FilterMap(Array[String]{"1", "x", "3"}, func(s String) (Integer, bool) { return s.ToI(), s != "x" }) -> [1, 3]
+func FilterMap[T, U any](a Array[T], fn func(T) (U, bool)) Array[U] {
+	result := make(Array[U], 0)
+	for _, v := range a {
+		if mapped, ok := fn(v); ok {
+			result = append(result, mapped)
+		}
+	}
+	return result
+}
+
+// EachWithObject calls fn with each element and the given object, then returns the object.
+// Example: EachWithObject(Array[String]{"a", "bb"}, Hash[String, Integer]{}, func(s String, h Hash[String, Integer]) { h[s] = s.Length() }) -> {"a": 1, "bb": 2}
+func EachWithObject[T, O any](a Array[T], obj O, fn func(T, O)) O {
+	for _, v := range a {
+		fn(v, obj)
+	}
+	return obj
+}
+
+// Zip pairs up the elements of two Arrays of possibly different types.
+// The result has the length of a; missing elements of b are filled with the zero value.
+// Example: Zip(Array[String]{"a", "b"}, Array[Integer]{1, 2}) -> [{a 1}, {b 2}]
+func Zip[T, U any](a Array[T], b Array[U]) Array[Pair[T, U]] {
+	result := make(Array[Pair[T, U]], len(a))
+	for i, v := range a {
+		result[i].Key = v
+		if i < len(b) {
+			result[i].Value = b[i]
+		}
+	}
+	return result
+}
+
+// Transpose swaps the rows and columns of a two-dimensional Array, as returned by Chunk.
+// It returns an error wrapping ErrRaggedArray if the rows have different lengths.
+// Example: Transpose([]Array[Integer]{{1, 2}, {3, 4}}) -> [[1, 3], [2, 4]], nil
+func Transpose[T any](rows []Array[T]) ([]Array[T], error) {
+	if len(rows) == 0 {
+		return []Array[T]{}, nil
+	}
+
+	width := len(rows[0])
+	for i, row := range rows {
+		if len(row) != width {
+			return nil, fmt.Errorf("%w: row %d has %d elements, should be %d", ErrRaggedArray, i, len(row), width)
+		}
+	}
+
+	result := make([]Array[T], width)
+	for col := range result {
+		result[col] = make(Array[T], len(rows))
+		for i, row := range rows {
+			result[col][i] = row[col]
+		}
+	}
+	return result, nil
+}
+
+// Flatten concatenates the nested Arrays into a single Array, keeping the element type.
+// Apply it repeatedly to flatten deeper structures.
+// Example: Flatten(Array[Array[Integer]]{{1, 2}, {3}}) -> [1, 2, 3]
+func Flatten[T any](a Array[Array[T]]) Array[T] {
+	return FlatMap(a, func(row Array[T]) Array[T] { return row })
+}
+
+// GroupBy groups the elements of the Array by the key returned from fn.
+// Example: GroupBy(Array[Integer]{1, 2, 3}, Integer.IsOdd) -> {true: [1, 3], false: [2]}
+func GroupBy[T any, K comparable](a Array[T], fn func(T) K) Hash[K, Array[T]] {
+	result := make(Hash[K, Array[T]])
+	for _, v := range a {
+		key := fn(v)
+		result[key] = append(result[key], v)
+	}
+	return result
+}
+
+// Tally counts the occurrences of each distinct element in the Array.
+// Example: Tally(Array[String]{"a", "b", "a"}) -> {"a": 2, "b": 1}
+func Tally[T comparable](a Array[T]) Hash[T, Integer] {
+	result := make(Hash[T, Integer])
+	for _, v := range a {
+		result[v]++
+	}
+	return result
+}
+
+// IndexBy builds a Hash mapping the key returned from fn to each element.
+// When several elements share a key, the last one wins.
+// Example: IndexBy(Array[String]{"a", "bb"}, String.Length) -> {1: "a", 2: "bb"}
+func IndexBy[T any, K comparable](a Array[T], fn func(T) K) Hash[K, T] {
+	result := make(Hash[K, T], len(a))
+	for _, v := range a {
+		result[fn(v)] = v
+	}
+	return result
+}
+
+// ToH builds a Hash from the key-value pairs returned by fn for each element.
+// When several elements produce the same key, the last one wins.
+// Example: ToH(Array[String]{"a", "bb"}, func(s String) (String, Integer) { return s, s.Length() }) -> {"a": 1, "bb": 2}
+func ToH[T any, K comparable, V any](a Array[T], fn func(T) (K, V)) Hash[K, V] {
+	result := make(Hash[K, V], len(a))
+	for _, v := range a {
+		key, value := fn(v)
+		result[key] = value
+	}
+	return result
+}
+
+// Sum returns the sum of all elements. Float arrays are summed with Kahan-Babuska
+// compensated summation, like Ruby's Array#sum, to reduce floating point error.
+// Example: Sum(Array[Integer]{1, 2, 3}) -> 6
+func Sum[T Integer | Float](a Array[T]) T {
+	if floats, ok := any(a).(Array[Float]); ok {
+		return T(kahanSum(floats))
+	}
+
+	var total T
+	for _, v := range a {
+		total += v
+	}
+	return total
+}
+
+// Product returns the product of all elements, or 1 for an empty Array.
+// Example: Product(Array[Integer]{2, 3, 4}) -> 24
+func Product[T Integer | Float](a Array[T]) T {
+	total := T(1)
+	for _, v := range a {
+		total *= v
+	}
+	return total
+}
+
+// Average returns the arithmetic mean of all elements as a Float, or 0 for an empty Array.
+// Example: Average(Array[Integer]{1, 2, 3, 4}) -> 2.5
+func Average[T Integer | Float](a Array[T]) Float {
+	if len(a) == 0 {
+		return 0
+	}
+	return Float(Sum(a)) / Float(len(a))
+}
+
+// kahanSum adds a with Kahan-Babuska summation. Like Ruby, it falls back to plain addition
+// once a value or the running sum is not finite, so Inf is kept and NaN propagates.
+func kahanSum(a Array[Float]) Float {
+	var sum, compensation Float
+	for i, v := range a {
+		t := sum + v
+		if !t.IsFinite() {
+			sum += compensation
+			for _, rest := range a[i:] {
+				sum += rest
+			}
+			return sum
+		}
+		if sum.Abs() >= v.Abs() {
+			compensation += (sum - t) + v
+		} else {
+			compensation += (v - t) + sum
+		}
+		sum = t
+	}
+	return sum + compensation
+}
+
+// equal reports whether a and b are equal, using == when both values are
+// comparable and reflect.DeepEqual otherwise.
+func equal[T any](a, b T) bool {
+	av, bv := any(a), any(b)
+	if isHashable(av) && isHashable(bv) {
+		return av == bv
+	}
+	return reflect.DeepEqual(av, bv)
+}
+
+// equalFor returns an equality function for values of type T that behaves like equal
+// but checks once, from the type, whether == can be used without looking at each value.
+func equalFor[T any]() func(a, b T) bool {
+	switch t := reflect.TypeFor[T](); {
+	case isStrictlyComparable(t):
+		return func(a, b T) bool { return any(a) == any(b) }
+	case !t.Comparable():
+		return func(a, b T) bool { return reflect.DeepEqual(any(a), any(b)) }
+	}
+	return equal[T]
+}
+
+// isStrictlyComparable reports whether every value of type t can be compared with ==
+// without panicking, which is not the case for interfaces or types containing them.
+func isStrictlyComparable(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Interface:
+		return false
+	case reflect.Array:
+		return isStrictlyComparable(t.Elem())
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if !isStrictlyComparable(t.Field(i).Type) {
+				return false
+			}
+		}
+		return true
+	}
+	return t.Comparable()
+}
+
+// isHashable reports whether v can be compared with == (and used as a map key)
+// without panicking.
+func isHashable(v any) bool {
+	if v == nil {
+		return true
+	}
+	switch reflect.TypeOf(v).Kind() {
+	case reflect.Array, reflect.Struct:
+		return reflect.ValueOf(v).Comparable()
+	case reflect.Func, reflect.Map, reflect.Slice:
+		return false
+	}
+	return true
+}
+
+// seenSet tracks keys that have already been encountered, hashing comparable
+// keys and falling back to a linear deep-equality scan for the rest.
+type seenSet struct {
+	hashable    map[any]bool
+	unhashable  Array[any]
+	allHashable bool
+}
+
+func newSeenSet() *seenSet {
+	return &seenSet{hashable: make(map[any]bool)}
+}
+
+// newSeenSetFor returns a seenSet for keys of type T, which skips checking each key
+// when every value of T is hashable.
+func newSeenSetFor[T any]() *seenSet {
+	s := newSeenSet()
+	s.allHashable = isStrictlyComparable(reflect.TypeFor[T]())
+	return s
+}
+
+// add records key and reports whether it had not been seen before.
+func (s *seenSet) add(key any) bool {
+	if s.allHashable || isHashable(key) {
+		if s.hashable[key] {
+			return false
+		}
+		s.hashable[key] = true
+		return true
+	}
+
+	if s.unhashable.Include(key) {
+		return false
+	}
+	s.unhashable = append(s.unhashable, key)
+	return true
+}
+
+// lookupOf returns a seenSet containing every element of the given Arrays.
+func lookupOf[T any](lists ...Array[T]) *seenSet {
+	lookup := newSeenSetFor[T]()
+	for _, list := range lists {
+		for _, v := range list {
+			lookup.add(v)
+		}
+	}
+	return lookup
+}
+
+// has reports whether key has been added.
+func (s *seenSet) has(key any) bool {
+	if s.allHashable || isHashable(key) {
+		return s.hashable[key]
+	}
+	return bool(s.unhashable.Include(key))
+}
+
+// isZero reports whether v is the zero value of its type.
+func isZero[T any](v T) bool {
+	rv := reflect.ValueOf(any(v))
+	return !rv.IsValid() || rv.IsZero()
+}
+
+// keyedSort sorts values by precomputed keys, keeping both slices in step.
+type keyedSort[T any] struct {
+	values []T
+	keys   []any
+}
+
+func (k keyedSort[T]) Len() int           { return len(k.values) }
+func (k keyedSort[T]) Less(i, j int) bool { return compareValues(k.keys[i], k.keys[j]) < 0 }
+func (k keyedSort[T]) Swap(i, j int) {
+	k.values[i], k.values[j] = k.values[j], k.values[i]
+	k.keys[i], k.keys[j] = k.keys[j], k.keys[i]
+}
+
+// compareValues orders a and b by their underlying kind, returning -1, 0 or 1.
+// Values of different or unordered kinds compare as equal.
+func compareValues(a, b any) int {
+	av, bv := reflect.ValueOf(a), reflect.ValueOf(b)
+	if !av.IsValid() || !bv.IsValid() {
+		return 0
+	}
+
+	switch {
+	case av.CanInt() && bv.CanInt():
+		return cmp.Compare(av.Int(), bv.Int())
+	case av.CanUint() && bv.CanUint():
+		return cmp.Compare(av.Uint(), bv.Uint())
+	case av.CanFloat() && bv.CanFloat():
+		return cmp.Compare(av.Float(), bv.Float())
+	case (av.CanInt() || av.CanFloat()) && (bv.CanInt() || bv.CanFloat()):
+		return cmp.Compare(toFloat64(av), toFloat64(bv))
+	case av.Kind() == reflect.String && bv.Kind() == reflect.String:
+		return cmp.Compare(av.String(), bv.String())
+	case av.Kind() == reflect.Bool && bv.Kind() == reflect.Bool:
+		return cmp.Compare(boolRank(av.Bool()), boolRank(bv.Bool()))
+	}
+	return 0
+}
+
+// compareKeys orders a and b like compareValues, falling back to their formatted values
+// for kinds without a natural order, such as structs, so that ties are broken the same way every time.
+func compareKeys[K any](a, b K) int {
+	if c := compareValues(a, b); c != 0 {
+		return c
+	}
+	return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
+
+func toFloat64(v reflect.Value) float64 {
+	if v.CanInt() {
+		return float64(v.Int())
+	}
+	return v.Float()
+}
+
+func boolRank(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
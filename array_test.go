@@ -1,6 +1,10 @@
 package rb
 
 import (
+	"errors"
+	"maps"
+	"math"
+	"slices"
 	"testing"
 )
 
@@ -230,6 +234,34 @@ func TestArray_Uniq(t *testing.T) {
 	}
 }
 
+func TestArray_IncludeByElementType(t *testing.T) {
+	type tagged struct {
+		Name  string
+		Value any
+	}
+	type listed struct {
+		Items []int
+	}
+
+	if !(Array[String]{"a", "b"}).Include("b") {
+		t.Errorf("Include() expected true for a comparable element type, got false")
+	}
+	wrapped := Array[tagged]{{"a", 1}, {"b", []int{1, 2}}}
+	if !wrapped.Include(tagged{"b", []int{1, 2}}) {
+		t.Errorf("Include() expected true for a struct holding a slice in an interface, got false")
+	}
+	if wrapped.Index(tagged{"a", 1}) != 0 || wrapped.RIndex(tagged{"b", []int{1}}) != -1 {
+		t.Errorf("Index() and RIndex() expected 0 and -1, got %d and %d", wrapped.Index(tagged{"a", 1}), wrapped.RIndex(tagged{"b", []int{1}}))
+	}
+	lists := Array[listed]{{[]int{1}}, {[]int{2}}, {[]int{1}}}
+	if lists.Count(listed{[]int{1}}) != 2 {
+		t.Errorf("Count() expected 2 for a non-comparable element type, got %d", lists.Count(listed{[]int{1}}))
+	}
+	if result := lists.Union(Array[listed]{{[]int{3}}}); len(result) != 3 {
+		t.Errorf("Union() expected 3 elements, got %v", result)
+	}
+}
+
 func TestArray_Compact(t *testing.T) {
 	array := Array[String]{"a", "", "b", "", "c"}
 	result := array.Compact()
@@ -307,6 +339,109 @@ func TestArray_Sort(t *testing.T) {
 	if len(result) != len(array) {
 		t.Errorf("Sort() expected length %d, got %d", len(array), len(result))
 	}
+	if result.Join(",") != "a,b,c" {
+		t.Errorf("Sort() expected a,b,c, got %s", result.Join(","))
+	}
+	if array.Join(",") != "c,a,b" {
+		t.Errorf("Sort() should not modify the receiver, got %s", array.Join(","))
+	}
+
+	ints := Array[Integer]{3, -1, 2, 10}.Sort()
+	if ints.Join(",") != "-1,2,3,10" {
+		t.Errorf("Sort() expected -1,2,3,10, got %s", ints.Join(","))
+	}
+
+	floats := Array[Float]{2.5, -0.5, 1.25}.Sort()
+	if floats.Join(",") != "-0.5,1.25,2.5" {
+		t.Errorf("Sort() expected -0.5,1.25,2.5, got %s", floats.Join(","))
+	}
+
+	bools := Array[Boolean]{true, false, true}.Sort()
+	if bools.Join(",") != "false,true,true" {
+		t.Errorf("Sort() expected false,true,true, got %s", bools.Join(","))
+	}
+}
+
+func TestArray_EnforceSort(t *testing.T) {
+	array := Array[Integer]{3, 1, 2}
+	array.EnforceSort()
+
+	if array.Join(",") != "1,2,3" {
+		t.Errorf("EnforceSort() expected 1,2,3, got %s", array.Join(","))
+	}
+}
+
+func TestArray_SortBy(t *testing.T) {
+	array := Array[String]{"ccc", "a", "bb", "d"}
+	result := array.SortBy(func(s String) any { return s.Length() })
+
+	// Stable: "a" stays before "d"
+	if result.Join(",") != "a,d,bb,ccc" {
+		t.Errorf("SortBy() expected a,d,bb,ccc, got %s", result.Join(","))
+	}
+
+	array.EnforceSortBy(func(s String) any { return -s.Length() })
+	if array.Join(",") != "ccc,bb,a,d" {
+		t.Errorf("EnforceSortBy() expected ccc,bb,a,d, got %s", array.Join(","))
+	}
+}
+
+func TestArray_SortWith(t *testing.T) {
+	type user struct {
+		Name string
+		Age  int
+	}
+
+	users := Array[user]{{"ann", 30}, {"bob", 25}, {"cid", 30}}
+	result := users.SortWith(func(x, y user) int { return y.Age - x.Age })
+
+	expected := []string{"ann", "cid", "bob"}
+	for i, u := range result {
+		if u.Name != expected[i] {
+			t.Errorf("SortWith() at index %d expected %s, got %s", i, expected[i], u.Name)
+		}
+	}
+
+	users.EnforceSortWith(func(x, y user) int { return x.Age - y.Age })
+	if users[0].Name != "bob" {
+		t.Errorf("EnforceSortWith() expected bob first, got %s", users[0].Name)
+	}
+}
+
+func TestArray_MaxMin(t *testing.T) {
+	array := Array[Integer]{3, 1, 4, 1, 5}
+
+	if maxVal := array.Max(); maxVal == nil || *maxVal != 5 {
+		t.Errorf("Max() expected 5, got %v", maxVal)
+	}
+	if minVal := array.Min(); minVal == nil || *minVal != 1 {
+		t.Errorf("Min() expected 1, got %v", minVal)
+	}
+
+	minVal, maxVal := Array[String]{"pear", "apple", "zoo"}.MinMax()
+	if minVal == nil || maxVal == nil || *minVal != "apple" || *maxVal != "zoo" {
+		t.Errorf("MinMax() expected apple, zoo, got %v, %v", minVal, maxVal)
+	}
+
+	empty := Array[Integer]{}
+	if empty.Max() != nil || empty.Min() != nil {
+		t.Error("Max()/Min() on empty Array should return nil")
+	}
+}
+
+func TestArray_MaxByMinBy(t *testing.T) {
+	array := Array[String]{"bb", "a", "ccc", "dd"}
+	length := func(s String) any { return s.Length() }
+
+	if result := array.MaxBy(length); result == nil || *result != "ccc" {
+		t.Errorf("MaxBy() expected ccc, got %v", result)
+	}
+	if result := array.MinBy(length); result == nil || *result != "a" {
+		t.Errorf("MinBy() expected a, got %v", result)
+	}
+	if result := (Array[String]{"bb", "dd"}).MaxBy(length); *result != "bb" {
+		t.Errorf("MaxBy() should return first of equal keys, got %v", *result)
+	}
 }
 
 func TestArray_Take(t *testing.T) {
@@ -410,3 +545,637 @@ func TestArray_Size(t *testing.T) {
 		t.Errorf("Size() should return same as Length(), expected %d, got %d", expected, result)
 	}
 }
+
+func TestArray_ArbitraryElementTypes(t *testing.T) {
+	type user struct {
+		ID   int
+		Name string
+	}
+
+	users := Array[user]{{1, "ann"}, {2, "bob"}, {1, "ann"}}
+	if !users.Include(user{2, "bob"}) {
+		t.Error("Include() should find struct element")
+	}
+	if idx := users.RIndex(user{1, "ann"}); idx != 2 {
+		t.Errorf("RIndex() expected 2, got %d", idx)
+	}
+	if count := users.Count(user{1, "ann"}); count != 2 {
+		t.Errorf("Count() expected 2, got %d", count)
+	}
+	if uniq := users.Uniq(); len(uniq) != 2 {
+		t.Errorf("Uniq() expected length 2, got %d", len(uniq))
+	}
+
+	// Non-comparable elements fall back to deep equality
+	nested := Array[[]int]{{1, 2}, {3}, {1, 2}}
+	if idx := nested.Index([]int{3}); idx != 1 {
+		t.Errorf("Index() expected 1, got %d", idx)
+	}
+	if uniq := nested.Uniq(); len(uniq) != 2 {
+		t.Errorf("Uniq() expected length 2, got %d", len(uniq))
+	}
+
+	// Pointers compare by identity and Compact drops nil
+	a, b := &user{1, "ann"}, &user{1, "ann"}
+	ptrs := Array[*user]{a, nil, b}
+	if ptrs.Index(b) != 2 {
+		t.Errorf("Index() expected pointer identity match at 2, got %d", ptrs.Index(b))
+	}
+	if compacted := ptrs.Compact(); len(compacted) != 2 {
+		t.Errorf("Compact() expected length 2, got %d", len(compacted))
+	}
+
+	// Nested Arrays
+	grid := Array[Array[Integer]]{{1, 2}, {3, 4}}
+	if !grid.Include(Array[Integer]{3, 4}) {
+		t.Error("Include() should find nested Array")
+	}
+}
+
+func TestArray_IncludeFunc(t *testing.T) {
+	type user struct {
+		ID   int
+		Name string
+	}
+
+	users := Array[user]{{1, "ann"}, {2, "bob"}}
+	sameID := func(a, b user) bool { return a.ID == b.ID }
+
+	if !users.IncludeFunc(user{ID: 2}, sameID) {
+		t.Error("IncludeFunc() should match by ID")
+	}
+	if users.IncludeFunc(user{ID: 3}, sameID) {
+		t.Error("IncludeFunc() should not match missing ID")
+	}
+}
+
+func TestArray_FindIndex(t *testing.T) {
+	array := Array[Integer]{1, 2, 3}
+
+	if idx := array.FindIndex(func(i Integer) bool { return i > 1 }); idx != 1 {
+		t.Errorf("FindIndex() expected 1, got %d", idx)
+	}
+	if idx := array.FindIndex(func(i Integer) bool { return i > 5 }); idx != -1 {
+		t.Errorf("FindIndex() expected -1, got %d", idx)
+	}
+}
+
+func TestArray_UniqBy(t *testing.T) {
+	array := Array[String]{"a", "B", "A", "b", "c"}
+	result := array.UniqBy(func(s String) any { return s.Downcase() })
+
+	expected := Array[String]{"a", "B", "c"}
+	if len(result) != len(expected) {
+		t.Fatalf("UniqBy() expected length %d, got %d", len(expected), len(result))
+	}
+	for i, val := range result {
+		if val != expected[i] {
+			t.Errorf("UniqBy() at index %d expected %s, got %s", i, expected[i], val)
+		}
+	}
+}
+
+func TestArray_Reduce(t *testing.T) {
+	array := Array[Integer]{1, 2, 3, 4}
+	add := func(acc, i Integer) Integer { return acc + i }
+
+	if result := array.Reduce(add); result == nil || *result != 10 {
+		t.Errorf("Reduce() expected 10, got %v", result)
+	}
+	if result := array.Inject(add); result == nil || *result != 10 {
+		t.Errorf("Inject() expected 10, got %v", result)
+	}
+	if result := (Array[Integer]{}).Reduce(add); result != nil {
+		t.Errorf("Reduce() on empty Array expected nil, got %v", *result)
+	}
+	if result := array.ReduceWith(100, add); result != 110 {
+		t.Errorf("ReduceWith() expected 110, got %d", result)
+	}
+	if result := (Array[Integer]{}).InjectWith(7, add); result != 7 {
+		t.Errorf("InjectWith() on empty Array expected 7, got %d", result)
+	}
+}
+
+func TestReduce(t *testing.T) {
+	words := Array[String]{"a", "bb", "ccc"}
+	lengths := Reduce(words, Hash[String, Integer]{}, func(h Hash[String, Integer], s String) Hash[String, Integer] {
+		h[s] = s.Length()
+		return h
+	})
+
+	if len(lengths) != 3 || lengths["ccc"] != 3 {
+		t.Errorf("Reduce() expected lookup map, got %v", lengths)
+	}
+
+	total := Reduce(words, Integer(0), func(acc Integer, s String) Integer { return acc + s.Length() })
+	if total != 6 {
+		t.Errorf("Reduce() expected 6, got %d", total)
+	}
+}
+
+func TestSumProductAverage(t *testing.T) {
+	ints := Array[Integer]{1, 2, 3, 4}
+	if result := Sum(ints); result != 10 {
+		t.Errorf("Sum() expected 10, got %d", result)
+	}
+	if result := Product(ints); result != 24 {
+		t.Errorf("Product() expected 24, got %d", result)
+	}
+	if result := Average(ints); result != 2.5 {
+		t.Errorf("Average() expected 2.5, got %g", result)
+	}
+
+	if result := Sum(Array[Integer]{}); result != 0 {
+		t.Errorf("Sum() on empty Array expected 0, got %d", result)
+	}
+	if result := Product(Array[Float]{}); result != 1 {
+		t.Errorf("Product() on empty Array expected 1, got %g", result)
+	}
+	if result := Average(Array[Float]{}); result != 0 {
+		t.Errorf("Average() on empty Array expected 0, got %g", result)
+	}
+}
+
+func TestSum_KahanFloat(t *testing.T) {
+	// Naive summation yields 0.6000000000000001 for these values
+	if result := Sum(Array[Float]{0.1, 0.2, 0.3}); result != 0.6 {
+		t.Errorf("Sum() expected 0.6, got %v", result)
+	}
+
+	// Naive summation loses the small values entirely
+	if result := Sum(Array[Float]{3.0, 1e100, -1e100}); result != 3.0 {
+		t.Errorf("Sum() expected 3.0, got %v", result)
+	}
+
+	// Non-finite values are added without compensation, as in Ruby
+	inf := Float(math.Inf(1))
+	if result := Sum(Array[Float]{inf}); result != inf {
+		t.Errorf("Sum() expected +Inf, got %v", result)
+	}
+	if result := Sum(Array[Float]{1, inf, 2}); result != inf {
+		t.Errorf("Sum() expected +Inf, got %v", result)
+	}
+	if result := Average(Array[Float]{1, -inf}); result != -inf {
+		t.Errorf("Average() expected -Inf, got %v", result)
+	}
+	if result := Sum(Array[Float]{1e308, 1e308}); result != inf {
+		t.Errorf("Sum() expected overflow to +Inf, got %v", result)
+	}
+	if result := Sum(Array[Float]{inf, -inf}); !result.IsNaN() {
+		t.Errorf("Sum() expected NaN for +Inf + -Inf, got %v", result)
+	}
+	if result := Sum(Array[Float]{1, Float(math.NaN()), inf}); !result.IsNaN() {
+		t.Errorf("Sum() expected NaN to propagate, got %v", result)
+	}
+}
+
+func TestMapTo(t *testing.T) {
+	words := Array[String]{"a", "bb", "ccc"}
+	lengths := MapTo(words, String.Length)
+
+	if lengths.Join(",") != "1,2,3" {
+		t.Errorf("MapTo() expected 1,2,3, got %s", lengths.Join(","))
+	}
+
+	// Composes with methods on the resulting Array
+	numbers := MapTo(Array[String]{"1", "2", "3", "4"}, String.ToI).Select(func(i Integer) bool { return bool(i.IsEven()) })
+	if numbers.Join("-") != "2-4" {
+		t.Errorf("MapTo().Select() expected 2-4, got %s", numbers.Join("-"))
+	}
+}
+
+func TestFlatMap(t *testing.T) {
+	lines := Array[String]{"a b", "", "c"}
+	result := FlatMap(lines, String.Words)
+
+	if result.Join(",") != "a,b,c" {
+		t.Errorf("FlatMap() expected a,b,c, got %s", result.Join(","))
+	}
+
+	ranges := FlatMap(Array[Integer]{1, 2}, func(i Integer) Array[Integer] { return NewRange(Integer(1), i).ToArray() })
+	if ranges.Join(",") != "1,1,2" {
+		t.Errorf("FlatMap() expected 1,1,2, got %s", ranges.Join(","))
+	}
+}
+
+func TestFilterMap(t *testing.T) {
+	input := Array[String]{"1", "x", "3"}
+	result := FilterMap(input, func(s String) (Integer, bool) {
+		return s.ToI(), s != "x"
+	})
+
+	if result.Join(",") != "1,3" {
+		t.Errorf("FilterMap() expected 1,3, got %s", result.Join(","))
+	}
+	if len(FilterMap(Array[String]{}, func(s String) (Integer, bool) { return 0, true })) != 0 {
+		t.Error("FilterMap() on empty Array should return empty Array")
+	}
+}
+
+func TestEachWithObject(t *testing.T) {
+	words := Array[String]{"a", "bb", "a"}
+	counts := EachWithObject(words, Hash[String, Integer]{}, func(s String, h Hash[String, Integer]) {
+		h[s]++
+	})
+
+	if counts["a"] != 2 || counts["bb"] != 1 {
+		t.Errorf("EachWithObject() expected {a: 2, bb: 1}, got %v", counts)
+	}
+}
+
+func TestArray_Partition(t *testing.T) {
+	array := Array[Integer]{1, 2, 3, 4, 5}
+	evens, odds := array.Partition(func(i Integer) bool { return i%2 == 0 })
+
+	if evens.Join(",") != "2,4" || odds.Join(",") != "1,3,5" {
+		t.Errorf("Partition() expected [2,4] [1,3,5], got %v %v", evens, odds)
+	}
+
+	all, none := array.Partition(func(Integer) bool { return true })
+	if len(all) != 5 || none == nil || len(none) != 0 {
+		t.Errorf("Partition() expected all selected and empty rejected, got %v %v", all, none)
+	}
+}
+
+func TestArray_ChunkWhile(t *testing.T) {
+	array := Array[Integer]{1, 2, 4, 9, 10, 11, 12, 15}
+	result := array.ChunkWhile(func(i, j Integer) bool { return j == i+1 })
+
+	expected := []string{"1,2", "4", "9,10,11,12", "15"}
+	if len(result) != len(expected) {
+		t.Fatalf("ChunkWhile() expected %d chunks, got %d", len(expected), len(result))
+	}
+	for i, chunk := range result {
+		if string(chunk.Join(",")) != expected[i] {
+			t.Errorf("ChunkWhile() chunk %d expected %s, got %s", i, expected[i], chunk.Join(","))
+		}
+	}
+
+	if len((Array[Integer]{}).ChunkWhile(func(i, j Integer) bool { return true })) != 0 {
+		t.Error("ChunkWhile() on empty Array should return no chunks")
+	}
+}
+
+func TestArray_SliceWhen(t *testing.T) {
+	array := Array[Integer]{1, 2, 4, 5, 7}
+	result := array.SliceWhen(func(i, j Integer) bool { return j != i+1 })
+
+	expected := []string{"1,2", "4,5", "7"}
+	if len(result) != len(expected) {
+		t.Fatalf("SliceWhen() expected %d chunks, got %d", len(expected), len(result))
+	}
+	for i, chunk := range result {
+		if string(chunk.Join(",")) != expected[i] {
+			t.Errorf("SliceWhen() chunk %d expected %s, got %s", i, expected[i], chunk.Join(","))
+		}
+	}
+}
+
+func TestArray_SliceBeforeAfter(t *testing.T) {
+	lines := Array[String]{"#a", "1", "#b", "2", "3"}
+	before := lines.SliceBefore(func(s String) bool { return bool(s.StartWith("#")) })
+	if len(before) != 2 || before[1].Join(",") != "#b,2,3" {
+		t.Errorf("SliceBefore() expected [[#a 1] [#b 2 3]], got %v", before)
+	}
+
+	stmts := Array[String]{"a", "b;", "c;", "d"}
+	after := stmts.SliceAfter(func(s String) bool { return bool(s.EndWith(";")) })
+	if len(after) != 3 || after[0].Join(",") != "a,b;" || after[2].Join(",") != "d" {
+		t.Errorf("SliceAfter() expected [[a b;] [c;] [d]], got %v", after)
+	}
+}
+
+func TestGroupBy(t *testing.T) {
+	array := Array[Integer]{1, 2, 3, 4, 5}
+	result := GroupBy(array, Integer.IsOdd)
+
+	if result[true].Join(",") != "1,3,5" || result[false].Join(",") != "2,4" {
+		t.Errorf("GroupBy() expected {true: [1 3 5], false: [2 4]}, got %v", result)
+	}
+}
+
+func TestTally(t *testing.T) {
+	result := Tally(Array[String]{"a", "b", "a", "c", "a"})
+
+	if result.Size() != 3 || result["a"] != 3 || result["b"] != 1 {
+		t.Errorf("Tally() expected {a: 3, b: 1, c: 1}, got %v", result)
+	}
+}
+
+func TestIndexBy(t *testing.T) {
+	result := IndexBy(Array[String]{"a", "bb", "cc"}, String.Length)
+
+	if result.Size() != 2 || result[1] != "a" || result[2] != "cc" {
+		t.Errorf("IndexBy() expected {1: a, 2: cc}, got %v", result)
+	}
+}
+
+func TestToH(t *testing.T) {
+	result := ToH(Array[String]{"a", "bb"}, func(s String) (String, Integer) {
+		return s.Upcase(), s.Length()
+	})
+
+	if result.Size() != 2 || result["A"] != 1 || result["BB"] != 2 {
+		t.Errorf("ToH() expected {A: 1, BB: 2}, got %v", result)
+	}
+}
+
+func TestArray_Zip(t *testing.T) {
+	array := Array[Integer]{1, 2, 3}
+	result := array.Zip(Array[Integer]{4, 5, 6}, Array[Integer]{7})
+
+	expected := []string{"1,4,7", "2,5,0", "3,6,0"}
+	if len(result) != len(expected) {
+		t.Fatalf("Zip() expected %d rows, got %d", len(expected), len(result))
+	}
+	for i, row := range result {
+		if string(row.Join(",")) != expected[i] {
+			t.Errorf("Zip() row %d expected %s, got %s", i, expected[i], row.Join(","))
+		}
+	}
+}
+
+func TestZip(t *testing.T) {
+	names := Array[String]{"a", "b", "c"}
+	result := Zip(names, Array[Integer]{1, 2})
+
+	if len(result) != 3 {
+		t.Fatalf("Zip() expected length 3, got %d", len(result))
+	}
+	if result[1].Key != "b" || result[1].Value != 2 {
+		t.Errorf("Zip() at index 1 expected {b 2}, got %v", result[1])
+	}
+	if result[2].Key != "c" || result[2].Value != 0 {
+		t.Errorf("Zip() at index 2 expected {c 0}, got %v", result[2])
+	}
+}
+
+func TestArray_Product(t *testing.T) {
+	array := Array[Integer]{1, 2}
+	result := array.Product(Array[Integer]{3, 4}, Array[Integer]{5})
+
+	expected := []string{"1,3,5", "1,4,5", "2,3,5", "2,4,5"}
+	if len(result) != len(expected) {
+		t.Fatalf("Product() expected %d rows, got %d", len(expected), len(result))
+	}
+	for i, row := range result {
+		if string(row.Join(",")) != expected[i] {
+			t.Errorf("Product() row %d expected %s, got %s", i, expected[i], row.Join(","))
+		}
+	}
+
+	if len(array.Product(Array[Integer]{})) != 0 {
+		t.Error("Product() with an empty Array should be empty")
+	}
+}
+
+func TestTranspose(t *testing.T) {
+	rows := Array[Integer]{1, 2, 3, 4, 5, 6}.Chunk(3)
+	result, err := Transpose(rows)
+	if err != nil {
+		t.Fatalf("Transpose() unexpected error: %v", err)
+	}
+
+	expected := []string{"1,4", "2,5", "3,6"}
+	if len(result) != len(expected) {
+		t.Fatalf("Transpose() expected %d rows, got %d", len(expected), len(result))
+	}
+	for i, row := range result {
+		if string(row.Join(",")) != expected[i] {
+			t.Errorf("Transpose() row %d expected %s, got %s", i, expected[i], row.Join(","))
+		}
+	}
+
+	_, err = Transpose(Array[Integer]{1, 2, 3}.Chunk(2))
+	if !errors.Is(err, ErrRaggedArray) {
+		t.Errorf("Transpose() expected ErrRaggedArray, got %v", err)
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	nested := Array[Array[Integer]]{{1, 2}, {}, {3}}
+	if result := Flatten(nested); result.Join(",") != "1,2,3" {
+		t.Errorf("Flatten() expected 1,2,3, got %s", result.Join(","))
+	}
+
+	if result := Flatten(Array[Integer]{1, 2, 3}.Chunk(2)); result.Join(",") != "1,2,3" {
+		t.Errorf("Flatten() of Chunk() expected 1,2,3, got %s", result.Join(","))
+	}
+
+	deep := Array[Array[Array[Integer]]]{{{1}, {2, 3}}, {{4}}}
+	if result := deep.Flatten(1); result.Length() != 3 {
+		t.Errorf("Flatten(1) expected length 3, got %d", result.Length())
+	}
+	if result := deep.Flatten(-1); result.Join(",") != "1,2,3,4" {
+		t.Errorf("Flatten(-1) expected 1,2,3,4, got %s", result.Join(","))
+	}
+}
+
+// collect drains a lazy Array iterator into joined strings for easy comparison.
+func collect[T any](seq func(yield func(Array[T]) bool)) []string {
+	result := []string{}
+	seq(func(a Array[T]) bool {
+		result = append(result, string(a.Join(",")))
+		return true
+	})
+	return result
+}
+
+func assertSeq(t *testing.T, name string, got, expected []string) {
+	t.Helper()
+	if len(got) != len(expected) {
+		t.Fatalf("%s expected %v, got %v", name, expected, got)
+	}
+	for i := range got {
+		if got[i] != expected[i] {
+			t.Errorf("%s at index %d expected %s, got %s", name, i, expected[i], got[i])
+		}
+	}
+}
+
+func TestArray_Combination(t *testing.T) {
+	array := Array[Integer]{1, 2, 3, 4}
+
+	assertSeq(t, "Combination(2)", collect(array.Combination(2)), []string{"1,2", "1,3", "1,4", "2,3", "2,4", "3,4"})
+	assertSeq(t, "Combination(0)", collect(array.Combination(0)), []string{""})
+	assertSeq(t, "Combination(5)", collect(array.Combination(5)), []string{})
+	assertSeq(t, "Combination(-1)", collect(array.Combination(-1)), []string{})
+}
+
+func TestArray_Permutation(t *testing.T) {
+	array := Array[Integer]{1, 2, 3}
+
+	assertSeq(t, "Permutation(2)", collect(array.Permutation(2)), []string{"1,2", "1,3", "2,1", "2,3", "3,1", "3,2"})
+	if count := len(collect(array.Permutation(3))); count != 6 {
+		t.Errorf("Permutation(3) expected 6 permutations, got %d", count)
+	}
+	assertSeq(t, "Permutation(4)", collect(array.Permutation(4)), []string{})
+}
+
+func TestArray_RepeatedCombination(t *testing.T) {
+	array := Array[Integer]{1, 2, 3}
+
+	assertSeq(t, "RepeatedCombination(2)", collect(array.RepeatedCombination(2)), []string{"1,1", "1,2", "1,3", "2,2", "2,3", "3,3"})
+	if count := len(collect(Array[Integer]{1, 2}.RepeatedCombination(4))); count != 5 {
+		t.Errorf("RepeatedCombination(4) expected 5 combinations, got %d", count)
+	}
+}
+
+func TestArray_RepeatedPermutation(t *testing.T) {
+	array := Array[Integer]{1, 2}
+
+	assertSeq(t, "RepeatedPermutation(2)", collect(array.RepeatedPermutation(2)), []string{"1,1", "1,2", "2,1", "2,2"})
+	assertSeq(t, "RepeatedPermutation(1) on empty", collect(Array[Integer]{}.RepeatedPermutation(1)), []string{})
+}
+
+func TestArray_CombinatoricsStopEarly(t *testing.T) {
+	// 20 choose 10 is 184756; stopping early must not generate them all.
+	array := NewRange(Integer(1), Integer(20)).ToArray()
+	seen := 0
+	array.Combination(10)(func(Array[Integer]) bool {
+		seen++
+		return seen < 3
+	})
+
+	if seen != 3 {
+		t.Errorf("Combination() should stop after yield returns false, saw %d", seen)
+	}
+}
+
+func TestArray_EachCons(t *testing.T) {
+	array := Array[Integer]{1, 2, 3, 4}
+
+	assertSeq(t, "EachCons(2)", collect(array.EachCons(2)), []string{"1,2", "2,3", "3,4"})
+	assertSeq(t, "EachCons(5)", collect(array.EachCons(5)), []string{})
+	assertSeq(t, "EachCons(0)", collect(array.EachCons(0)), []string{})
+}
+
+func TestArray_EachSlice(t *testing.T) {
+	array := Array[Integer]{1, 2, 3, 4, 5}
+
+	chunks := array.Chunk(2)
+	expected := make([]string, len(chunks))
+	for i, c := range chunks {
+		expected[i] = string(c.Join(","))
+	}
+
+	assertSeq(t, "EachSlice(2)", collect(array.EachSlice(2)), expected)
+	assertSeq(t, "EachSlice(0)", collect(array.EachSlice(0)), []string{})
+}
+
+func TestArray_Seq(t *testing.T) {
+	array := Array[Integer]{1, 2, 3, 4}
+
+	sum := Integer(0)
+	for v := range array.Seq() {
+		if v > 2 {
+			break
+		}
+		sum += v
+	}
+	if sum != 3 {
+		t.Errorf("Seq() with break expected sum 3, got %d", sum)
+	}
+
+	if collected := slices.Collect(array.Seq()); len(collected) != 4 || collected[3] != 4 {
+		t.Errorf("slices.Collect(Seq()) expected [1 2 3 4], got %v", collected)
+	}
+
+	pairs := 0
+	for range array.Combination(2) {
+		pairs++
+	}
+	if pairs != 6 {
+		t.Errorf("range over Combination(2) expected 6 pairs, got %d", pairs)
+	}
+}
+
+func TestArrayFrom(t *testing.T) {
+	result := ArrayFrom(slices.Values([]String{"a", "b"}))
+	if result.Join(",") != "a,b" {
+		t.Errorf("ArrayFrom() expected a,b, got %s", result.Join(","))
+	}
+
+	keys := ArrayFrom(maps.Keys(Hash[String, Integer]{"x": 1})).Sort()
+	if keys.Join(",") != "x" {
+		t.Errorf("ArrayFrom(maps.Keys()) expected x, got %s", keys.Join(","))
+	}
+
+	empty := ArrayFrom(NewExclusiveRange(Integer(1), Integer(1)).Seq())
+	if empty == nil || len(empty) != 0 {
+		t.Errorf("ArrayFrom() of empty iterator expected empty Array, got %v", empty)
+	}
+}
+
+func TestArray_Union(t *testing.T) {
+	array := Array[Integer]{1, 2, 2}
+
+	if result := array.Union(Array[Integer]{2, 3}, Array[Integer]{4, 1}); result.Join(",") != "1,2,3,4" {
+		t.Errorf("Union() expected 1,2,3,4, got %s", result.Join(","))
+	}
+	if result := array.Union(); result.Join(",") != "1,2" {
+		t.Errorf("Union() with no arguments expected 1,2, got %s", result.Join(","))
+	}
+}
+
+func TestArray_Intersection(t *testing.T) {
+	array := Array[Integer]{1, 1, 3, 5}
+
+	if result := array.Intersection(Array[Integer]{3, 2, 1}); result.Join(",") != "1,3" {
+		t.Errorf("Intersection() expected 1,3, got %s", result.Join(","))
+	}
+	if result := array.Intersection(Array[Integer]{1, 3}, Array[Integer]{3}); result.Join(",") != "3" {
+		t.Errorf("Intersection() with two Arrays expected 3, got %s", result.Join(","))
+	}
+	if result := array.Intersection(Array[Integer]{}); len(result) != 0 {
+		t.Errorf("Intersection() with empty Array expected empty, got %v", result)
+	}
+}
+
+func TestArray_Difference(t *testing.T) {
+	array := Array[Integer]{1, 1, 2, 2, 3, 3, 4, 5}
+
+	if result := array.Difference(Array[Integer]{1, 2, 4}); result.Join(",") != "3,3,5" {
+		t.Errorf("Difference() expected 3,3,5, got %s", result.Join(","))
+	}
+	if result := array.Difference(Array[Integer]{3}, Array[Integer]{5}); result.Join(",") != "1,1,2,2,4" {
+		t.Errorf("Difference() with two Arrays expected 1,1,2,2,4, got %s", result.Join(","))
+	}
+}
+
+func TestArray_IsIntersect(t *testing.T) {
+	array := Array[Integer]{1, 2, 3}
+
+	if !array.IsIntersect(Array[Integer]{3, 4}) {
+		t.Error("IsIntersect() expected true")
+	}
+	if array.IsIntersect(Array[Integer]{4, 5}) {
+		t.Error("IsIntersect() expected false")
+	}
+}
+
+func TestArray_SetOperatorsNonComparable(t *testing.T) {
+	array := Array[[]int]{{1}, {2}, {1}}
+
+	if result := array.Difference(Array[[]int]{{1}}); len(result) != 1 {
+		t.Errorf("Difference() on slices expected 1 element, got %v", result)
+	}
+	if result := array.Union(Array[[]int]{{3}}); len(result) != 3 {
+		t.Errorf("Union() on slices expected 3 elements, got %v", result)
+	}
+}
+
+func TestArray_SetOperatorsLarge(t *testing.T) {
+	big := NewRange(Integer(1), Integer(50_000)).ToArray()
+	evens := big.Select(func(i Integer) bool { return bool(i.IsEven()) })
+
+	if result := big.Difference(evens); len(result) != 25_000 {
+		t.Errorf("Difference() expected 25000 elements, got %d", len(result))
+	}
+	if result := big.Intersection(evens); len(result) != 25_000 {
+		t.Errorf("Intersection() expected 25000 elements, got %d", len(result))
+	}
+	if result := evens.Union(big); len(result) != 50_000 {
+		t.Errorf("Union() expected 50000 elements, got %d", len(result))
+	}
+}
@@ -0,0 +1,168 @@
+// Package rb provides Ruby-inspired utility methods for Go types.
+package rb
+
+import "reflect"
+
+// ArrayMerge controls how DeepMerge combines two slice values under the same key.
+type ArrayMerge int
+
+const (
+	// ReplaceArrays keeps the slice from the other Hash, as with any other conflicting value.
+	ReplaceArrays ArrayMerge = iota
+	// ConcatArrays appends the slice from the other Hash to the receiver's slice.
+	ConcatArrays
+)
+
+// MergeWith merges another Hash into a copy of this Hash, calling fn with the key, the
+// receiver's value and the other value for keys present in both, like Ruby's merge with a block.
+// Example: Hash[string, int]{"a": 1}.MergeWith(Hash[string, int]{"a": 2}, func(k string, old, new int) int { return old + new }) -> {"a": 3}
+func (h Hash[K, V]) MergeWith(other Hash[K, V], fn func(key K, oldValue, newValue V) V) Hash[K, V] {
+	result := h.Clone()
+	for k, v := range other {
+		if old, exists := result[k]; exists {
+			v = fn(k, old, v)
+		}
+		result[k] = v
+	}
+	return result
+}
+
+// DeepMerge merges another Hash into a deep copy of this Hash. Where both Hashes hold maps
+// (including Hash) of the same type under a key, they are merged recursively; slices are
+// replaced, or concatenated if ConcatArrays is passed; any other value from other wins.
+// Neither Hash is modified, and the result shares no maps or slices with them.
+// Example: Hash[string, any]{"db": Hash[string, any]{"host": "x", "port": 1}}.DeepMerge(Hash[string, any]{"db": Hash[string, any]{"port": 2}}) -> {"db": {"host": "x", "port": 2}}
+func (h Hash[K, V]) DeepMerge(other Hash[K, V], arrays ...ArrayMerge) Hash[K, V] {
+	return h.DeepMergeWith(other, nil, arrays...)
+}
+
+// DeepMergeWith is like DeepMerge but calls fn for conflicting values that are not merged
+// recursively, like ActiveSupport's deep_merge with a block. fn is called at every depth
+// where the nested map has the same key and value types as the Hash, such as Hash[string, any]
+// values nested in a Hash[string, any]; elsewhere the value from other wins.
+// Example: Hash[string, any]{"n": 1}.DeepMergeWith(Hash[string, any]{"n": 2}, func(k string, old, new any) any { return old }) -> {"n": 1}
+func (h Hash[K, V]) DeepMergeWith(other Hash[K, V], fn func(key K, oldValue, newValue V) V, arrays ...ArrayMerge) Hash[K, V] {
+	m := deepMerger{keyType: reflect.TypeFor[K](), valueType: reflect.TypeFor[V]()}
+	if len(arrays) > 0 {
+		m.arrays = arrays[0]
+	}
+	if fn != nil {
+		m.conflict = func(key, oldValue, newValue reflect.Value) reflect.Value {
+			var k K
+			var o, n V
+			reflect.ValueOf(&k).Elem().Set(key)
+			reflect.ValueOf(&o).Elem().Set(oldValue)
+			reflect.ValueOf(&n).Elem().Set(newValue)
+			result := fn(k, o, n)
+			return reflect.ValueOf(&result).Elem()
+		}
+	}
+
+	result := h.DeepClone()
+	m.mergeMaps(reflect.ValueOf(result), reflect.ValueOf(other))
+	return result
+}
+
+// DeepClone returns a copy of the Hash that shares no maps or slices with it, copying nested
+// maps (including Hash) and slices (including Array) recursively. Pointers and structs are
+// copied shallowly, and cyclic values are not supported.
+// Example: Hash[string, any]{"a": Array[int]{1}}.DeepClone()
+func (h Hash[K, V]) DeepClone() Hash[K, V] {
+	result := make(Hash[K, V], len(h))
+	for k, v := range h {
+		reflect.ValueOf(&v).Elem().Set(deepCopy(reflect.ValueOf(&v).Elem()))
+		result[k] = v
+	}
+	return result
+}
+
+// deepMerger merges maps recursively for DeepMerge.
+type deepMerger struct {
+	arrays    ArrayMerge
+	keyType   reflect.Type
+	valueType reflect.Type
+	conflict  func(key, oldValue, newValue reflect.Value) reflect.Value
+}
+
+// mergeMaps merges src into dst, which must be a map that may be modified.
+func (m deepMerger) mergeMaps(dst, src reflect.Value) {
+	withConflict := m.conflict != nil && dst.Type().Key() == m.keyType && dst.Type().Elem() == m.valueType
+
+	for it := src.MapRange(); it.Next(); {
+		key, newValue := it.Key(), it.Value()
+		oldValue := dst.MapIndex(key)
+		if !oldValue.IsValid() {
+			dst.SetMapIndex(key, deepCopy(newValue))
+			continue
+		}
+
+		if merged, ok := m.mergeValues(oldValue, newValue); ok {
+			dst.SetMapIndex(key, merged)
+		} else if withConflict {
+			dst.SetMapIndex(key, deepCopy(m.conflict(key, oldValue, newValue)))
+		} else {
+			dst.SetMapIndex(key, deepCopy(newValue))
+		}
+	}
+}
+
+// mergeValues combines two maps or, under ConcatArrays, two slices of the same type.
+func (m deepMerger) mergeValues(oldValue, newValue reflect.Value) (reflect.Value, bool) {
+	o, n := concreteValue(oldValue), concreteValue(newValue)
+	if !o.IsValid() || !n.IsValid() || o.Type() != n.Type() {
+		return reflect.Value{}, false
+	}
+
+	switch {
+	case o.Kind() == reflect.Map && !o.IsNil():
+		merged := deepCopy(o)
+		m.mergeMaps(merged, n)
+		return merged, true
+	case o.Kind() == reflect.Slice && m.arrays == ConcatArrays:
+		return reflect.AppendSlice(deepCopy(o), deepCopy(n)), true
+	}
+	return reflect.Value{}, false
+}
+
+// concreteValue unwraps interface values, returning the zero Value for nil interfaces.
+func concreteValue(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+// deepCopy copies maps and slices recursively, returning a Value of the same type as v.
+func deepCopy(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		result := reflect.New(v.Type()).Elem()
+		result.Set(deepCopy(v.Elem()))
+		return result
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		result := reflect.MakeMapWithSize(v.Type(), v.Len())
+		for it := v.MapRange(); it.Next(); {
+			result.SetMapIndex(it.Key(), deepCopy(it.Value()))
+		}
+		return result
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		result := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			result.Index(i).Set(deepCopy(v.Index(i)))
+		}
+		return result
+	}
+	return v
+}
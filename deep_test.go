@@ -0,0 +1,138 @@
+package rb
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestHash_MergeWith(t *testing.T) {
+	hash := Hash[String, Integer]{"a": 1, "b": 2}
+	other := Hash[String, Integer]{"b": 10, "c": 3}
+
+	result := hash.MergeWith(other, func(k String, oldValue, newValue Integer) Integer { return oldValue + newValue })
+	expected := Hash[String, Integer]{"a": 1, "b": 12, "c": 3}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("MergeWith() expected %v, got %v", expected, result)
+	}
+	if hash["b"] != 2 {
+		t.Errorf("MergeWith() expected receiver unchanged, got %v", hash)
+	}
+}
+
+func TestHash_DeepMerge(t *testing.T) {
+	base := Hash[string, any]{
+		"name": "app",
+		"db": Hash[string, any]{
+			"host": "localhost",
+			"port": 5432,
+			"opts": map[string]int{"pool": 5, "timeout": 30},
+		},
+		"tags": Array[string]{"base"},
+	}
+	override := Hash[string, any]{
+		"db": Hash[string, any]{
+			"port": 6543,
+			"opts": map[string]int{"pool": 10},
+		},
+		"tags":  Array[string]{"prod"},
+		"debug": false,
+	}
+
+	result := base.DeepMerge(override)
+	expected := Hash[string, any]{
+		"name": "app",
+		"db": Hash[string, any]{
+			"host": "localhost",
+			"port": 6543,
+			"opts": map[string]int{"pool": 10, "timeout": 30},
+		},
+		"tags":  Array[string]{"prod"},
+		"debug": false,
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("DeepMerge() expected %v, got %v", expected, result)
+	}
+
+	concat := base.DeepMerge(override, ConcatArrays)
+	if tags := concat["tags"].(Array[string]); tags.Join(",") != "base,prod" {
+		t.Errorf("DeepMerge(ConcatArrays) expected tags base,prod, got %v", tags)
+	}
+
+	if base["db"].(Hash[string, any])["port"] != 5432 || len(base["db"].(Hash[string, any])["opts"].(map[string]int)) != 2 {
+		t.Errorf("DeepMerge() expected receiver unchanged, got %v", base)
+	}
+	result["db"].(Hash[string, any])["host"] = "changed"
+	if base["db"].(Hash[string, any])["host"] != "localhost" {
+		t.Errorf("DeepMerge() expected result not to share nested maps with the receiver")
+	}
+}
+
+func TestHash_DeepMergeMismatchedTypes(t *testing.T) {
+	base := Hash[string, any]{"db": Hash[string, any]{"port": 1}, "list": Array[int]{1}}
+	override := Hash[string, any]{"db": "sqlite", "list": Array[string]{"x"}}
+
+	result := base.DeepMerge(override, ConcatArrays)
+	if result["db"] != "sqlite" || !reflect.DeepEqual(result["list"], Array[string]{"x"}) {
+		t.Errorf("DeepMerge() expected values of different types to be replaced, got %v", result)
+	}
+}
+
+func TestHash_DeepMergeWith(t *testing.T) {
+	base := Hash[string, any]{"retries": 3, "db": Hash[string, any]{"port": 1, "pool": 5}}
+	override := Hash[string, any]{"retries": 5, "db": Hash[string, any]{"port": 2, "pool": nil}}
+
+	keys := Array[string]{}
+	result := base.DeepMergeWith(override, func(k string, oldValue, newValue any) any {
+		keys = keys.Push(k)
+		if newValue == nil {
+			return oldValue
+		}
+		return oldValue.(int) + newValue.(int)
+	})
+
+	expected := Hash[string, any]{"retries": 8, "db": Hash[string, any]{"port": 3, "pool": 5}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("DeepMergeWith() expected %v, got %v", expected, result)
+	}
+	if keys.Sort().Join(",") != "pool,port,retries" {
+		t.Errorf("DeepMergeWith() expected block for pool,port,retries, got %v", keys.Sort())
+	}
+
+	nilResult := Hash[string, any]{"a": 1}.DeepMergeWith(Hash[string, any]{"a": 2}, func(string, any, any) any { return nil })
+	if value, exists := nilResult["a"]; !exists || value != nil {
+		t.Errorf("DeepMergeWith() expected a block returning nil to store nil, got %v", nilResult)
+	}
+}
+
+func TestHash_DeepMergeTypedValues(t *testing.T) {
+	base := Hash[String, Hash[String, Integer]]{"a": {"x": 1, "y": 2}}
+	override := Hash[String, Hash[String, Integer]]{"a": {"y": 20}, "b": {"z": 3}}
+
+	result := base.DeepMerge(override)
+	expected := Hash[String, Hash[String, Integer]]{"a": {"x": 1, "y": 20}, "b": {"z": 3}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("DeepMerge() expected %v, got %v", expected, result)
+	}
+}
+
+func TestHash_DeepClone(t *testing.T) {
+	original := Hash[string, any]{
+		"nested": Hash[string, any]{"list": Array[int]{1, 2}},
+		"plain":  map[string][]string{"k": {"v"}},
+		"nil":    nil,
+	}
+
+	clone := original.DeepClone()
+	if !reflect.DeepEqual(clone, original) {
+		t.Errorf("DeepClone() expected %v, got %v", original, clone)
+	}
+
+	clone["nested"].(Hash[string, any])["list"].(Array[int])[0] = 100
+	clone["plain"].(map[string][]string)["k"][0] = "changed"
+	if original["nested"].(Hash[string, any])["list"].(Array[int])[0] != 1 || original["plain"].(map[string][]string)["k"][0] != "v" {
+		t.Errorf("DeepClone() expected nested values not to be shared, got %v", original)
+	}
+	if len(Hash[string, any](nil).DeepClone()) != 0 {
+		t.Errorf("DeepClone() of nil Hash expected empty Hash")
+	}
+}
@@ -0,0 +1,85 @@
+// Package rb provides Ruby-inspired utility methods for Go types.
+package rb
+
+// DefaultHash is a Hash with a default value or default function used by Lookup for
+// missing keys, like Ruby's Hash.new(0) and Hash.new { |h, k| h[k] = [] }.
+// Every Hash method is available on it; like Ruby's fetch, Fetch ignores the default.
+type DefaultHash[K comparable, V any] struct {
+	Hash[K, V]
+	value V
+	proc  func(Hash[K, V], K) V
+}
+
+// NewDefaultHash creates an empty DefaultHash whose missing keys look up as value.
+// The default is returned but not stored, as with Ruby's Hash.new(value).
+// Example: NewDefaultHash[string, int](0).Lookup("a") -> 0
+func NewDefaultHash[K comparable, V any](value V) *DefaultHash[K, V] {
+	return make(Hash[K, V]).WithDefault(value)
+}
+
+// NewDefaultProcHash creates an empty DefaultHash whose missing keys look up as the result of fn.
+// fn receives the Hash and the key, and may store the value it returns.
+// Example: NewDefaultProcHash(func(h Hash[string, Array[int]], k string) Array[int] { h[k] = Array[int]{}; return h[k] })
+func NewDefaultProcHash[K comparable, V any](fn func(Hash[K, V], K) V) *DefaultHash[K, V] {
+	return make(Hash[K, V]).WithDefaultProc(fn)
+}
+
+// WithDefault wraps the Hash in a DefaultHash with the given default value.
+// The DefaultHash shares its pairs with the Hash.
+// Example: Hash[string, int]{"a": 1}.WithDefault(0).Lookup("b") -> 0
+func (h Hash[K, V]) WithDefault(value V) *DefaultHash[K, V] {
+	return &DefaultHash[K, V]{Hash: h, value: value}
+}
+
+// WithDefaultProc wraps the Hash in a DefaultHash with the given default function.
+// The DefaultHash shares its pairs with the Hash.
+// Example: Hash[string, int]{}.WithDefaultProc(func(h Hash[string, int], k string) int { return len(k) }).Lookup("ab") -> 2
+func (h Hash[K, V]) WithDefaultProc(fn func(Hash[K, V], K) V) *DefaultHash[K, V] {
+	return &DefaultHash[K, V]{Hash: h, proc: fn}
+}
+
+// Lookup returns the value for the given key, or the default for it if the key doesn't exist,
+// like Ruby's h[key].
+// Example: Hash[string, int]{"a": 1}.WithDefault(5).Lookup("b") -> 5
+func (d *DefaultHash[K, V]) Lookup(key K) V {
+	if value, exists := d.Hash[key]; exists {
+		return value
+	}
+	return d.Default(key)
+}
+
+// Default returns the default for the given key, calling the default function if there is one.
+// Example: NewDefaultHash[string, int](5).Default("a") -> 5
+func (d *DefaultHash[K, V]) Default(key K) V {
+	if d.proc != nil {
+		return d.proc(d.Hash, key)
+	}
+	return d.value
+}
+
+// SetDefault sets the default value and removes any default function, as in Ruby.
+func (d *DefaultHash[K, V]) SetDefault(value V) *DefaultHash[K, V] {
+	d.value, d.proc = value, nil
+	return d
+}
+
+// SetDefaultProc sets the default function, which takes precedence over the default value.
+func (d *DefaultHash[K, V]) SetDefaultProc(fn func(Hash[K, V], K) V) *DefaultHash[K, V] {
+	d.proc = fn
+	return d
+}
+
+// DefaultProc returns the default function, or nil if the DefaultHash uses a default value.
+func (d *DefaultHash[K, V]) DefaultProc() func(Hash[K, V], K) V {
+	return d.proc
+}
+
+// ValuesAt returns the values for the given keys, using the default for missing keys.
+// Example: Hash[string, int]{"a": 1}.WithDefault(-1).ValuesAt("a", "b") -> [1, -1]
+func (d *DefaultHash[K, V]) ValuesAt(keys ...K) Array[V] {
+	result := make(Array[V], len(keys))
+	for i, k := range keys {
+		result[i] = d.Lookup(k)
+	}
+	return result
+}
@@ -0,0 +1,85 @@
+package rb
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDefaultHash_Value(t *testing.T) {
+	counts := NewDefaultHash[String, Integer](0)
+	words := Array[String]{"a", "b", "a"}
+	for _, word := range words {
+		counts.Set(word, counts.Lookup(word)+1)
+	}
+
+	if counts.Lookup("a") != 2 || counts.Lookup("b") != 1 {
+		t.Errorf("Lookup() expected a=2 b=1, got %v", counts.Hash)
+	}
+	if counts.Lookup("z") != 0 {
+		t.Errorf("Lookup() for missing key expected 0, got %d", counts.Lookup("z"))
+	}
+	if counts.HasKey("z") {
+		t.Errorf("Lookup() expected default value not to be stored")
+	}
+}
+
+func TestDefaultHash_Proc(t *testing.T) {
+	groups := NewDefaultProcHash(func(h Hash[Integer, Array[String]], k Integer) Array[String] {
+		h[k] = Array[String]{}
+		return h[k]
+	})
+	words := Array[String]{"a", "bb", "c"}
+	for _, word := range words {
+		groups.Set(word.Length(), groups.Lookup(word.Length()).Push(word))
+	}
+
+	if groups.Lookup(1).Join(",") != "a,c" || groups.Lookup(2).Join(",") != "bb" {
+		t.Errorf("Lookup() expected {1: a,c, 2: bb}, got %v", groups.Hash)
+	}
+	if len(groups.Lookup(3)) != 0 || !groups.HasKey(3) {
+		t.Errorf("Lookup() expected default proc to store an empty Array for 3")
+	}
+}
+
+func TestDefaultHash_WithDefault(t *testing.T) {
+	hash := Hash[String, Integer]{"a": 1}
+	withDefault := hash.WithDefault(-1)
+
+	if withDefault.Lookup("a") != 1 || withDefault.Lookup("b") != -1 {
+		t.Errorf("WithDefault() expected a=1 b=-1, got a=%d b=%d", withDefault.Lookup("a"), withDefault.Lookup("b"))
+	}
+	withDefault.Set("c", 3)
+	if hash["c"] != 3 {
+		t.Errorf("WithDefault() expected to share pairs with the Hash")
+	}
+
+	withDefault.SetDefaultProc(func(h Hash[String, Integer], k String) Integer { return k.Length() })
+	if withDefault.Lookup("xyz") != 3 || withDefault.Default("xy") != 2 {
+		t.Errorf("SetDefaultProc() expected length of the key, got %d", withDefault.Lookup("xyz"))
+	}
+	withDefault.SetDefault(7)
+	if withDefault.DefaultProc() != nil || withDefault.Lookup("xyz") != 7 {
+		t.Errorf("SetDefault() expected to replace the default proc, got %d", withDefault.Lookup("xyz"))
+	}
+}
+
+func TestDefaultHash_FetchIgnoresDefault(t *testing.T) {
+	hash := NewDefaultHash[String, Integer](0)
+
+	if hash.FetchOr("a", func(k String) Integer { return 42 }) != 42 {
+		t.Errorf("FetchOr() expected fallback 42")
+	}
+
+	var keyErr *KeyError
+	if _, err := hash.Fetch("a"); !errors.As(err, &keyErr) || keyErr.Key != String("a") {
+		t.Errorf("Fetch() expected *KeyError for key a, got %v", err)
+	}
+}
+
+func TestDefaultHash_ValuesAt(t *testing.T) {
+	hash := Hash[String, Integer]{"a": 1}.WithDefault(-1)
+
+	if hash.ValuesAt("a", "b").Join(",") != "1,-1" {
+		t.Errorf("ValuesAt() expected 1,-1, got %v", hash.ValuesAt("a", "b"))
+	}
+}
@@ -0,0 +1,168 @@
+// Package rb provides Ruby-inspired utility methods for Go types.
+package rb
+
+import (
+	"errors"
+	"iter"
+)
+
+// ErrStopIteration is returned by Enumerator.Next and Enumerator.Peek once the
+// enumerator has no more values, like Ruby's StopIteration.
+var ErrStopIteration = errors.New("rb: iteration reached an end")
+
+// Enumerator is an external enumerator, similar to Ruby's Enumerator.
+// Values are pulled one at a time with Next and Peek instead of being pushed to
+// a callback, so several enumerators can be advanced in step with each other.
+// An Enumerator is not safe for concurrent use.
+type Enumerator[T any] struct {
+	seq    iter.Seq[T]
+	size   *Integer
+	next   func() (T, bool)
+	stop   func()
+	value  T
+	peeked bool
+	done   bool
+}
+
+// Yielder receives the values produced by a generator passed to NewEnumerator.
+type Yielder[T any] struct {
+	yield func(T) bool
+}
+
+// generatorStopped unwinds a generator once its consumer no longer wants values.
+type generatorStopped struct{}
+
+// Yield hands a value to the consumer of the Enumerator, like Ruby's y << value.
+// If the consumer has stopped, the generator is unwound and Yield does not return.
+func (y *Yielder[T]) Yield(value T) {
+	if !y.yield(value) {
+		panic(generatorStopped{})
+	}
+}
+
+// NewEnumerator creates an Enumerator whose values are produced by a generator function.
+// The generator runs lazily, only as far as the values that are consumed, so it may be infinite.
+// Example: NewEnumerator(func(y *Yielder[Integer]) { for i := Integer(1); ; i++ { y.Yield(i * i) } }).Next() -> 1, nil
+func NewEnumerator[T any](generator func(y *Yielder[T])) *Enumerator[T] {
+	return EnumeratorFrom(func(yield func(T) bool) {
+		defer func() {
+			if r := recover(); r != nil {
+				if _, ok := r.(generatorStopped); !ok {
+					panic(r)
+				}
+			}
+		}()
+		generator(&Yielder[T]{yield: yield})
+	})
+}
+
+// EnumeratorFrom creates an Enumerator over the values of an iterator.
+// Example: EnumeratorFrom(slices.Values([]Integer{1, 2}))
+func EnumeratorFrom[T any](seq iter.Seq[T]) *Enumerator[T] {
+	return &Enumerator[T]{seq: seq}
+}
+
+// EnumFor creates an Enumerator from any Each-style method, like Ruby's enum_for.
+// Example: EnumFor(Integer(3).Times).Next() -> 0, nil
+func EnumFor[T any](each func(func(T))) *Enumerator[T] {
+	return NewEnumerator(func(y *Yielder[T]) {
+		each(y.Yield)
+	})
+}
+
+// ToEnum returns an Enumerator over the elements of the Array.
+// Example: Array[String]{"a", "b"}.ToEnum().Next() -> "a", nil
+func (a Array[T]) ToEnum() *Enumerator[T] {
+	return EnumeratorFrom(a.Seq()).WithSize(a.Length())
+}
+
+// ToEnum returns an Enumerator over the values in the Range.
+// Example: NewRange(Integer(1), Integer(3)).ToEnum().Next() -> 1, nil
+func (r Range[T]) ToEnum() *Enumerator[T] {
+	return EnumeratorFrom(r.Seq()).WithSize(r.Size())
+}
+
+// ToEnum returns an Enumerator over the key-value pairs of the Hash.
+// Example: Hash[string, int]{"a": 1}.ToEnum().Next() -> {"a", 1}, nil
+func (h Hash[K, V]) ToEnum() *Enumerator[Pair[K, V]] {
+	return EnumeratorFrom(h.Lazy().Seq()).WithSize(h.Size())
+}
+
+// WithSize records the number of values the Enumerator will produce, as reported by Size.
+func (e *Enumerator[T]) WithSize(size Integer) *Enumerator[T] {
+	e.size = &size
+	return e
+}
+
+// Size returns the number of values the Enumerator produces, or nil if it is not known
+// without enumerating, as for generators.
+// Example: Array[Integer]{1, 2}.ToEnum().Size() -> 2
+func (e *Enumerator[T]) Size() *Integer {
+	return e.size
+}
+
+// Next returns the next value and advances the Enumerator.
+// At the end it returns ErrStopIteration until Rewind is called.
+// Example: Array[Integer]{1}.ToEnum().Next() -> 1, nil
+func (e *Enumerator[T]) Next() (T, error) {
+	value, err := e.Peek()
+	if err != nil {
+		return value, err
+	}
+
+	var zero T
+	e.value, e.peeked = zero, false
+	return value, nil
+}
+
+// Peek returns the next value without advancing the Enumerator.
+// At the end it returns ErrStopIteration until Rewind is called.
+// Example: Array[Integer]{1}.ToEnum().Peek() -> 1, nil
+func (e *Enumerator[T]) Peek() (T, error) {
+	if e.peeked {
+		return e.value, nil
+	}
+
+	var zero T
+	if e.done {
+		return zero, ErrStopIteration
+	}
+
+	if e.next == nil {
+		e.next, e.stop = iter.Pull(e.seq)
+	}
+
+	value, ok := e.next()
+	if !ok {
+		e.done = true
+		e.release()
+		return zero, ErrStopIteration
+	}
+
+	e.value, e.peeked = value, true
+	return value, nil
+}
+
+// Rewind resets the Enumerator to its first value and returns it.
+// It also releases the resources held by a partially consumed Enumerator,
+// so call it when abandoning one before reaching the end.
+func (e *Enumerator[T]) Rewind() *Enumerator[T] {
+	e.release()
+
+	var zero T
+	e.value, e.peeked, e.done = zero, false, false
+	return e
+}
+
+// Seq returns an iterator over all values of the Enumerator from the beginning,
+// independent of the position reached with Next.
+func (e *Enumerator[T]) Seq() iter.Seq[T] {
+	return e.seq
+}
+
+func (e *Enumerator[T]) release() {
+	if e.stop != nil {
+		e.stop()
+	}
+	e.next, e.stop = nil, nil
+}
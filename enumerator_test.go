@@ -0,0 +1,150 @@
+package rb
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestEnumerator_NextPeek(t *testing.T) {
+	e := Array[String]{"a", "b"}.ToEnum()
+
+	if v, err := e.Peek(); err != nil || v != "a" {
+		t.Errorf("Peek() expected a, got %v (%v)", v, err)
+	}
+	if v, err := e.Next(); err != nil || v != "a" {
+		t.Errorf("Next() expected a, got %v (%v)", v, err)
+	}
+	if v, err := e.Next(); err != nil || v != "b" {
+		t.Errorf("Next() expected b, got %v (%v)", v, err)
+	}
+	if _, err := e.Next(); !errors.Is(err, ErrStopIteration) {
+		t.Errorf("Next() at end expected ErrStopIteration, got %v", err)
+	}
+	if _, err := e.Peek(); !errors.Is(err, ErrStopIteration) {
+		t.Errorf("Peek() at end expected ErrStopIteration, got %v", err)
+	}
+}
+
+func TestEnumerator_Rewind(t *testing.T) {
+	e := NewRange(Integer(1), Integer(3)).ToEnum()
+	e.Next()
+	e.Next()
+
+	if v, err := e.Rewind().Next(); err != nil || v != 1 {
+		t.Errorf("Rewind().Next() expected 1, got %v (%v)", v, err)
+	}
+
+	for {
+		if _, err := e.Next(); err != nil {
+			break
+		}
+	}
+	if v, err := e.Rewind().Peek(); err != nil || v != 1 {
+		t.Errorf("Rewind() after exhaustion expected 1, got %v (%v)", v, err)
+	}
+	e.Rewind()
+}
+
+func TestEnumerator_Size(t *testing.T) {
+	if size := (Array[Integer]{1, 2, 3}).ToEnum().Size(); size == nil || *size != 3 {
+		t.Errorf("Size() expected 3, got %v", size)
+	}
+	if size := NewRange(Integer(1), Integer(10)).ToEnum().Size(); size == nil || *size != 10 {
+		t.Errorf("Size() expected 10, got %v", size)
+	}
+	if size := (Hash[String, Integer]{"a": 1}).ToEnum().Size(); size == nil || *size != 1 {
+		t.Errorf("Size() expected 1, got %v", size)
+	}
+
+	gen := NewEnumerator(func(y *Yielder[Integer]) { y.Yield(1) })
+	if gen.Size() != nil {
+		t.Errorf("Size() of generator expected nil, got %d", *gen.Size())
+	}
+	if size := gen.WithSize(1).Size(); size == nil || *size != 1 {
+		t.Errorf("WithSize(1).Size() expected 1, got %v", size)
+	}
+}
+
+func TestNewEnumerator_InfiniteGenerator(t *testing.T) {
+	fib := NewEnumerator(func(y *Yielder[Integer]) {
+		a, b := Integer(0), Integer(1)
+		for {
+			y.Yield(a)
+			a, b = b, a+b
+		}
+	})
+
+	values := Array[Integer]{}
+	for i := 0; i < 8; i++ {
+		v, err := fib.Next()
+		if err != nil {
+			t.Fatalf("Next() unexpected error: %v", err)
+		}
+		values = values.Push(v)
+	}
+	if values.Join(",") != "0,1,1,2,3,5,8,13" {
+		t.Errorf("fibonacci generator expected 0,1,1,2,3,5,8,13, got %s", values.Join(","))
+	}
+
+	// Abandoning an infinite generator must not hang
+	fib.Rewind()
+
+	count := 0
+	for range fib.Seq() {
+		count++
+		if count == 5 {
+			break
+		}
+	}
+	if count != 5 {
+		t.Errorf("Seq() with break expected 5 values, got %d", count)
+	}
+}
+
+func TestNewEnumerator_PropagatesPanics(t *testing.T) {
+	defer func() {
+		if r := recover(); r != "boom" {
+			t.Errorf("generator panic expected to propagate, got %v", r)
+		}
+	}()
+
+	e := NewEnumerator(func(y *Yielder[Integer]) { panic("boom") })
+	e.Next()
+}
+
+func TestEnumFor(t *testing.T) {
+	times := EnumFor(Integer(3).Times)
+	if v, _ := times.Next(); v != 0 {
+		t.Errorf("EnumFor(Times).Next() expected 0, got %d", v)
+	}
+
+	keys := EnumFor(Hash[String, Integer]{"a": 1}.EachKey)
+	if v, err := keys.Next(); err != nil || v != "a" {
+		t.Errorf("EnumFor(EachKey).Next() expected a, got %v (%v)", v, err)
+	}
+	keys.Rewind()
+}
+
+func TestEnumerator_Interleave(t *testing.T) {
+	letters := Array[String]{"a", "b", "c"}.ToEnum()
+	numbers := EnumFor(NewRange(Integer(1), Integer(2)).Each)
+
+	merged := Array[String]{}
+	for {
+		l, lerr := letters.Next()
+		if lerr == nil {
+			merged = merged.Push(l)
+		}
+		n, nerr := numbers.Next()
+		if nerr == nil {
+			merged = merged.Push(n.ToS())
+		}
+		if lerr != nil && nerr != nil {
+			break
+		}
+	}
+
+	if merged.Join(",") != "a,1,b,2,c" {
+		t.Errorf("interleaving expected a,1,b,2,c, got %s", merged.Join(","))
+	}
+}
@@ -163,8 +163,8 @@ func main() {
 	fmt.Println("--- Hash Methods ---")
 	hash := rb.Hash[rb.String, rb.Integer]{"a": 1, "b": 2, "c": 3, "d": 4}
 	fmt.Printf("Original: %v\n", hash)
-	fmt.Printf("Keys: %v\n", hash.Keys())
-	fmt.Printf("Values: %v\n", hash.Values())
+	fmt.Printf("Keys: %v\n", hash.SortedKeys())
+	fmt.Printf("Values: %v\n", hash.Values().Sort())
 	fmt.Printf("Size: %d\n", hash.Size())
 	fmt.Printf("HasKey 'a': %t\n", hash.HasKey("a"))
 	fmt.Printf("HasKey 'z': %t\n", hash.HasKey("z"))
@@ -1,26 +1,54 @@
 package rb
 
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"reflect"
+)
+
 // Hash is a generic map type to emulate Ruby-like hash behavior.
 type Hash[K comparable, V any] map[K]V
 
-// Keys returns an Array of all keys in the Hash.
+// ErrKeyCollision is returned by RejectCollision when two pairs map to the same key.
+var ErrKeyCollision = errors.New("rb: key collision")
+
+// KeyError reports a key missing from a Hash, like Ruby's KeyError.
+type KeyError struct {
+	Key      any
+	Receiver any
+}
+
+func (e *KeyError) Error() string {
+	return fmt.Sprintf("rb: key not found: %v", e.Key)
+}
+
+// Keys returns an Array of all keys in the Hash, in no particular order.
 // Example: Hash[string, int]{"a": 1, "b": 2}.Keys() -> ["a", "b"]
-func (h Hash[K, V]) Keys() AnyArray {
-	keys := make([]any, 0, len(h))
+func (h Hash[K, V]) Keys() Array[K] {
+	keys := make(Array[K], 0, len(h))
 	for k := range h {
 		keys = append(keys, k)
 	}
-	return AnyArray(keys)
+	return keys
 }
 
-// Values returns an Array of all values in the Hash.
+// SortedKeys returns an Array of all keys in the Hash in ascending order. Keys without a
+// natural order, such as structs, are ordered by their formatted value, as for Sort.
+// Example: Hash[string, int]{"b": 2, "a": 1}.SortedKeys() -> ["a", "b"]
+func (h Hash[K, V]) SortedKeys() Array[K] {
+	keys := h.Keys()
+	return keys.EnforceSortWith(compareKeys[K])
+}
+
+// Values returns an Array of all values in the Hash, in no particular order.
 // Example: Hash[string, int]{"a": 1, "b": 2}.Values() -> [1, 2]
-func (h Hash[K, V]) Values() AnyArray {
-	values := make([]any, 0, len(h))
+func (h Hash[K, V]) Values() Array[V] {
+	values := make(Array[V], 0, len(h))
 	for _, v := range h {
 		values = append(values, v)
 	}
-	return AnyArray(values)
+	return values
 }
 
 // HasKey checks if the Hash contains the given key.
@@ -39,13 +67,132 @@ func (h Hash[K, V]) Get(key K, defaultValue V) V {
 	return defaultValue
 }
 
-// Fetch retrieves the value for the given key, panicking if the key doesn't exist.
-// Example: Hash[string, int]{"a": 1}.Fetch("a") -> 1
-func (h Hash[K, V]) Fetch(key K) V {
+// Fetch retrieves the value for the given key, returning a *KeyError if the key doesn't exist.
+// Example: Hash[string, int]{"a": 1}.Fetch("a") -> 1, nil
+func (h Hash[K, V]) Fetch(key K) (V, error) {
+	if value, exists := h[key]; exists {
+		return value, nil
+	}
+	var zero V
+	return zero, &KeyError{Key: key, Receiver: h}
+}
+
+// FetchOr retrieves the value for the given key, calling fallback with the key if it doesn't exist,
+// like Ruby's fetch with a block.
+// Example: Hash[string, int]{"a": 1}.FetchOr("b", func(k string) int { return len(k) }) -> 1
+func (h Hash[K, V]) FetchOr(key K, fallback func(K) V) V {
 	if value, exists := h[key]; exists {
 		return value
 	}
-	panic("key not found")
+	return fallback(key)
+}
+
+// ValuesAt returns the values for the given keys, using the zero value for missing keys.
+// Example: Hash[string, int]{"a": 1, "b": 2}.ValuesAt("b", "c") -> [2, 0]
+func (h Hash[K, V]) ValuesAt(keys ...K) Array[V] {
+	result := make(Array[V], len(keys))
+	for i, k := range keys {
+		result[i] = h[k]
+	}
+	return result
+}
+
+// FetchValues returns the values for the given keys, or a *KeyError for the first missing key.
+// Example: Hash[string, int]{"a": 1, "b": 2}.FetchValues("b", "a") -> [2, 1], nil
+func (h Hash[K, V]) FetchValues(keys ...K) (Array[V], error) {
+	result := make(Array[V], len(keys))
+	for i, k := range keys {
+		value, err := h.Fetch(k)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = value
+	}
+	return result, nil
+}
+
+// Dig retrieves a nested value by following key, then each element of path through nested
+// maps (including Hash) and slices (including Array), like Ruby's dig. Slice indices may be
+// negative to count from the end. A missing key or index is reported as a *KeyError whose
+// Receiver is the map or slice it was missing from, and so is a step into nil or a nil map,
+// slice or pointer; a step into any other kind of value, or a path element that cannot be
+// used as its key or index, is reported as ErrTypeMismatch.
+// Example: Hash[string, any]{"a": Array[int]{1, 2}}.Dig("a", -1) -> 2, nil
+func (h Hash[K, V]) Dig(key K, path ...any) (any, error) {
+	current, err := h.Fetch(key)
+	if err != nil {
+		return nil, err
+	}
+
+	var value any = current
+	for _, step := range path {
+		if value, err = digStep(value, step); err != nil {
+			return nil, err
+		}
+	}
+	return value, nil
+}
+
+// digStep looks up a single key or index of a map or slice for Dig.
+func digStep(container, step any) (any, error) {
+	cv := reflect.ValueOf(container)
+	if isNilContainer(cv) {
+		return nil, &KeyError{Key: step, Receiver: container}
+	}
+
+	switch cv.Kind() {
+	case reflect.Map:
+		kv := reflect.ValueOf(step)
+		keyType := cv.Type().Key()
+		switch {
+		case !kv.IsValid():
+			return nil, fmt.Errorf("%w: cannot use nil as a key of %T", ErrTypeMismatch, container)
+		case kv.Type().AssignableTo(keyType):
+		case kv.Kind() == keyType.Kind() && kv.Type().ConvertibleTo(keyType):
+			kv = kv.Convert(keyType)
+		default:
+			return nil, fmt.Errorf("%w: cannot use %T as a key of %T", ErrTypeMismatch, step, container)
+		}
+
+		value := cv.MapIndex(kv)
+		if !value.IsValid() {
+			return nil, &KeyError{Key: step, Receiver: container}
+		}
+		return value.Interface(), nil
+
+	case reflect.Slice, reflect.Array:
+		iv := reflect.ValueOf(step)
+		var index int
+		switch {
+		case iv.CanInt():
+			index = int(iv.Int())
+		case iv.CanUint():
+			index = int(iv.Uint())
+		default:
+			return nil, fmt.Errorf("%w: cannot use %T as an index of %T", ErrTypeMismatch, step, container)
+		}
+
+		if index < 0 {
+			index += cv.Len()
+		}
+		if index < 0 || index >= cv.Len() {
+			return nil, &KeyError{Key: step, Receiver: container}
+		}
+		return cv.Index(index).Interface(), nil
+	}
+	return nil, fmt.Errorf("%w: cannot dig into %T", ErrTypeMismatch, container)
+}
+
+// isNilContainer reports whether v is nil, or a nil map, pointer or slice, which Dig treats
+// as holding no keys.
+func isNilContainer(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Invalid:
+		return true
+	case reflect.Map, reflect.Pointer, reflect.Slice:
+		return v.IsNil()
+	}
+	return false
 }
 
 // Set sets the value for the given key.
@@ -144,6 +291,43 @@ func (h Hash[K, V]) Each(fn func(K, V)) {
 	}
 }
 
+// Seq returns an iterator over the key-value pairs of the Hash, for use with
+// range-over-func and the standard maps package.
+// Example: for k, v := range Hash[string, int]{"a": 1}.Seq() { fmt.Println(k, v) }
+func (h Hash[K, V]) Seq() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range h {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// HashFrom collects the key-value pairs of an iterator into a new Hash.
+// Later pairs overwrite earlier ones with the same key.
+// Example: HashFrom(maps.All(map[string]int{"a": 1})) -> {"a": 1}
+func HashFrom[K comparable, V any](seq iter.Seq2[K, V]) Hash[K, V] {
+	result := make(Hash[K, V])
+	for k, v := range seq {
+		result[k] = v
+	}
+	return result
+}
+
+// TryEach is like Each for callbacks that can fail. By default it stops at the first error
+// and returns it; pass CollectErrors to visit every pair and return all errors joined.
+// Example: Hash[string, int]{"a": 1}.TryEach(func(k string, v int) error { return store(k, v) })
+func (h Hash[K, V]) TryEach(fn func(K, V) error, mode ...ErrorMode) error {
+	errs := newErrorCollector(mode)
+	for k, v := range h {
+		if errs.add(fn(k, v)) {
+			break
+		}
+	}
+	return errs.err()
+}
+
 // EachKey applies the given function to each key.
 // Example: Hash[string, int]{"a": 1}.EachKey(func(k string) { fmt.Println(k) })
 func (h Hash[K, V]) EachKey(fn func(K)) {
@@ -161,6 +345,7 @@ func (h Hash[K, V]) EachValue(fn func(V)) {
 }
 
 // Map transforms the Hash by applying the given function to each key-value pair.
+// Colliding keys overwrite each other; use MapHashWith to change types or handle collisions.
 // Example: Hash[string, int]{"a": 1}.Map(func(k string, v int) (string, int) { return k + "x", v * 2 })
 func (h Hash[K, V]) Map(fn func(K, V) (K, V)) Hash[K, V] {
 	result := make(Hash[K, V])
@@ -171,8 +356,52 @@ func (h Hash[K, V]) Map(fn func(K, V) (K, V)) Hash[K, V] {
 	return result
 }
 
+// TrySelect is like Select for predicates that can fail, with errors handled as for TryEach.
+// The returned Hash is nil whenever the error is not.
+// Example: Hash[string, int]{"a": 1, "b": 2}.TrySelect(func(k string, v int) (bool, error) { return v > 1, nil }) -> {"b": 2}, nil
+func (h Hash[K, V]) TrySelect(predicate func(K, V) (bool, error), mode ...ErrorMode) (Hash[K, V], error) {
+	errs := newErrorCollector(mode)
+	result := make(Hash[K, V])
+	for k, v := range h {
+		keep, err := predicate(k, v)
+		if errs.add(err) {
+			break
+		}
+		if err == nil && keep {
+			result[k] = v
+		}
+	}
+
+	if err := errs.err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// TryMap is like Map for callbacks that can fail, with errors handled as for TryEach.
+// The returned Hash is nil whenever the error is not.
+// Example: Hash[string, int]{"a": 1}.TryMap(func(k string, v int) (string, int, error) { return k, v * 2, nil }) -> {"a": 2}, nil
+func (h Hash[K, V]) TryMap(fn func(K, V) (K, V, error), mode ...ErrorMode) (Hash[K, V], error) {
+	errs := newErrorCollector(mode)
+	result := make(Hash[K, V])
+	for k, v := range h {
+		newKey, newValue, err := fn(k, v)
+		if errs.add(err) {
+			break
+		}
+		if err == nil {
+			result[newKey] = newValue
+		}
+	}
+
+	if err := errs.err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 // Invert returns a new Hash with keys and values swapped.
-// Note: This only works if all values are unique and comparable.
+// Note: This only works if all values are unique and comparable; use the Invert function for a typed result.
 // Example: Hash[string, int]{"a": 1}.Invert() -> {1: "a"}
 func (h Hash[K, V]) Invert() Hash[any, any] {
 	result := make(Hash[any, any])
@@ -182,14 +411,131 @@ func (h Hash[K, V]) Invert() Hash[any, any] {
 	return result
 }
 
-// ToArray converts the Hash to an Array of key-value pairs.
+// ToArray converts the Hash to an Array of key-value pairs, in no particular order.
 // Example: Hash[string, int]{"a": 1}.ToArray() -> [{"a", 1}]
-func (h Hash[K, V]) ToArray() AnyArray {
-	result := make([]any, 0, len(h))
+func (h Hash[K, V]) ToArray() Array[Pair[K, V]] {
+	result := make(Array[Pair[K, V]], 0, len(h))
 	for k, v := range h {
 		result = append(result, Pair[K, V]{Key: k, Value: v})
 	}
-	return AnyArray(result)
+	return result
+}
+
+// Sort returns the key-value pairs of the Hash as an Array sorted by key.
+// Example: Hash[string, int]{"b": 1, "a": 2}.Sort() -> [{"a", 2}, {"b", 1}]
+func (h Hash[K, V]) Sort() Array[Pair[K, V]] {
+	return h.SortBy(func(k K, _ V) any { return k })
+}
+
+// SortBy returns the key-value pairs of the Hash as an Array sorted by the key returned from fn.
+// Pairs with equal sort keys are ordered by their Hash key, or by its formatted value for keys
+// without a natural order such as structs, so the result is deterministic.
+// Example: Hash[string, int]{"a": 2, "b": 1}.SortBy(func(k string, v int) any { return v }) -> [{"b", 1}, {"a", 2}]
+func (h Hash[K, V]) SortBy(fn func(K, V) any) Array[Pair[K, V]] {
+	pairs := h.ToArray()
+	keys := make(map[K]any, len(pairs))
+	for _, p := range pairs {
+		keys[p.Key] = fn(p.Key, p.Value)
+	}
+	return pairs.EnforceSortWith(func(x, y Pair[K, V]) int {
+		if c := compareValues(keys[x.Key], keys[y.Key]); c != 0 {
+			return c
+		}
+		return compareKeys(x.Key, y.Key)
+	})
+}
+
+// MaxBy returns the key-value pair for which fn returns the largest value, or nil if the Hash is empty.
+// Ties go to the pair with the smallest key, comparing keys without a natural order such as
+// structs by their formatted value, so the result does not depend on iteration order.
+// Example: Hash[string, int]{"a": 1, "b": 3}.MaxBy(func(k string, v int) any { return v }) -> {"b", 3}
+func (h Hash[K, V]) MaxBy(fn func(K, V) any) *Pair[K, V] {
+	return h.extremeBy(fn, 1)
+}
+
+// MinBy returns the key-value pair for which fn returns the smallest value, or nil if the Hash is empty.
+// Ties go to the pair with the smallest key, comparing keys without a natural order such as
+// structs by their formatted value, so the result does not depend on iteration order.
+// Example: Hash[string, int]{"a": 1, "b": 3}.MinBy(func(k string, v int) any { return v }) -> {"a", 1}
+func (h Hash[K, V]) MinBy(fn func(K, V) any) *Pair[K, V] {
+	return h.extremeBy(fn, -1)
+}
+
+func (h Hash[K, V]) extremeBy(fn func(K, V) any, sign int) *Pair[K, V] {
+	var best *Pair[K, V]
+	var bestKey any
+	for k, v := range h {
+		key := fn(k, v)
+		if best != nil {
+			c := compareValues(key, bestKey) * sign
+			if c < 0 || (c == 0 && compareKeys(k, best.Key) >= 0) {
+				continue
+			}
+		}
+		best, bestKey = &Pair[K, V]{Key: k, Value: v}, key
+	}
+	return best
+}
+
+// Count returns the number of key-value pairs for which the predicate returns true.
+// Example: Hash[string, int]{"a": 1, "b": 2}.Count(func(k string, v int) bool { return v > 1 }) -> 1
+func (h Hash[K, V]) Count(predicate func(K, V) bool) Integer {
+	count := Integer(0)
+	for k, v := range h {
+		if predicate(k, v) {
+			count++
+		}
+	}
+	return count
+}
+
+// Find returns a key-value pair for which the predicate returns true, or nil if there is none.
+// If several pairs match, which one is returned is unspecified.
+// Example: Hash[string, int]{"a": 1, "b": 2}.Find(func(k string, v int) bool { return v > 1 }) -> {"b", 2}
+func (h Hash[K, V]) Find(predicate func(K, V) bool) *Pair[K, V] {
+	for k, v := range h {
+		if predicate(k, v) {
+			return &Pair[K, V]{Key: k, Value: v}
+		}
+	}
+	return nil
+}
+
+// Detect is an alias for Find.
+func (h Hash[K, V]) Detect(predicate func(K, V) bool) *Pair[K, V] {
+	return h.Find(predicate)
+}
+
+// Any checks if the predicate returns true for any key-value pair.
+// Example: Hash[string, int]{"a": 1}.Any(func(k string, v int) bool { return v > 0 }) -> true
+func (h Hash[K, V]) Any(predicate func(K, V) bool) Boolean {
+	return h.Find(predicate) != nil
+}
+
+// All checks if the predicate returns true for every key-value pair.
+// Example: Hash[string, int]{"a": 1, "b": 0}.All(func(k string, v int) bool { return v > 0 }) -> false
+func (h Hash[K, V]) All(predicate func(K, V) bool) Boolean {
+	return !h.Any(func(k K, v V) bool { return !predicate(k, v) })
+}
+
+// None checks if the predicate returns false for every key-value pair.
+// Example: Hash[string, int]{"a": 1}.None(func(k string, v int) bool { return v > 1 }) -> true
+func (h Hash[K, V]) None(predicate func(K, V) bool) Boolean {
+	return !h.Any(predicate)
+}
+
+// Partition splits the Hash into the pairs for which the predicate returns true and those for which it returns false.
+// Example: Hash[string, int]{"a": 1, "b": 2}.Partition(func(k string, v int) bool { return v > 1 }) -> {"b": 2}, {"a": 1}
+func (h Hash[K, V]) Partition(predicate func(K, V) bool) (selected, rejected Hash[K, V]) {
+	selected, rejected = make(Hash[K, V]), make(Hash[K, V])
+	for k, v := range h {
+		if predicate(k, v) {
+			selected[k] = v
+		} else {
+			rejected[k] = v
+		}
+	}
+	return selected, rejected
 }
 
 // Clone returns a shallow copy of the Hash.
@@ -239,8 +585,151 @@ func (h Hash[K, V]) DeleteIf(predicate func(K, V) bool) {
 	}
 }
 
-// Pair represents a key-value pair in a Hash.
-type Pair[K comparable, V any] struct {
+// KeyCollision decides the value kept when two pairs of a Hash map to the same key.
+// It receives the key, the value already stored and the incoming value. Pairs are
+// visited in ascending order of their original keys, so "existing" comes from the
+// smaller original key; keys without a natural order, such as structs, are ordered
+// by their formatted value.
+type KeyCollision[K, V any] func(key K, existing, incoming V) (V, error)
+
+// KeepFirst is a KeyCollision that keeps the value from the smallest original key.
+func KeepFirst[K, V any](_ K, existing, _ V) (V, error) {
+	return existing, nil
+}
+
+// KeepLast is a KeyCollision that keeps the value from the largest original key.
+func KeepLast[K, V any](_ K, _, incoming V) (V, error) {
+	return incoming, nil
+}
+
+// RejectCollision is a KeyCollision that fails with ErrKeyCollision.
+func RejectCollision[K, V any](key K, existing, _ V) (V, error) {
+	return existing, fmt.Errorf("%w: %v", ErrKeyCollision, key)
+}
+
+// MergeCollision returns a KeyCollision that combines colliding values with fn.
+// Example: TransformKeysWith(h, strings.ToLower, MergeCollision(func(k string, a, b int) int { return a + b }))
+func MergeCollision[K, V any](fn func(key K, existing, incoming V) V) KeyCollision[K, V] {
+	return func(key K, existing, incoming V) (V, error) {
+		return fn(key, existing, incoming), nil
+	}
+}
+
+// MapHash transforms the keys and values of a Hash into a Hash of different types.
+// When several pairs map to the same key, the one with the largest original key wins.
+// Example: MapHash(Hash[string, int]{"a": 1}, func(k string, v int) (int, string) { return v, k }) -> {1: "a"}
+func MapHash[K, K2 comparable, V, V2 any](h Hash[K, V], fn func(K, V) (K2, V2)) Hash[K2, V2] {
+	result, _ := MapHashWith(h, fn, KeepLast)
+	return result
+}
+
+// MapHashWith is like MapHash but resolves colliding keys with the given policy.
+// The returned Hash is nil whenever the error is not.
+// Example: MapHashWith(h, fn, RejectCollision) -> nil, ErrKeyCollision
+func MapHashWith[K, K2 comparable, V, V2 any](h Hash[K, V], fn func(K, V) (K2, V2), policy KeyCollision[K2, V2]) (Hash[K2, V2], error) {
+	result := make(Hash[K2, V2], len(h))
+	for _, k := range h.SortedKeys() {
+		newKey, newValue := fn(k, h[k])
+		if existing, ok := result[newKey]; ok {
+			var err error
+			if newValue, err = policy(newKey, existing, newValue); err != nil {
+				return nil, err
+			}
+		}
+		result[newKey] = newValue
+	}
+	return result, nil
+}
+
+// TransformKeys returns a new Hash with the keys transformed by fn, like Ruby's transform_keys.
+// When several keys map to the same key, the value of the largest original key wins.
+// Example: TransformKeys(Hash[string, int]{"a": 1}, func(k string) String { return String(k).Upcase() }) -> {"A": 1}
+func TransformKeys[K, K2 comparable, V any](h Hash[K, V], fn func(K) K2) Hash[K2, V] {
+	result, _ := TransformKeysWith(h, fn, KeepLast)
+	return result
+}
+
+// TransformKeysWith is like TransformKeys but resolves colliding keys with the given policy.
+// The returned Hash is nil whenever the error is not.
+// Example: TransformKeysWith(Hash[string, int]{"a": 1, "A": 2}, strings.ToLower, KeepFirst) -> {"a": 2}, nil
+func TransformKeysWith[K, K2 comparable, V any](h Hash[K, V], fn func(K) K2, policy KeyCollision[K2, V]) (Hash[K2, V], error) {
+	return MapHashWith(h, func(k K, v V) (K2, V) { return fn(k), v }, policy)
+}
+
+// TransformValues returns a new Hash with the values transformed by fn, like Ruby's transform_values.
+// Example: TransformValues(Hash[string, int]{"a": 1}, func(v int) String { return Integer(v).ToS() }) -> {"a": "1"}
+func TransformValues[K comparable, V, V2 any](h Hash[K, V], fn func(V) V2) Hash[K, V2] {
+	result := make(Hash[K, V2], len(h))
+	for k, v := range h {
+		result[k] = fn(v)
+	}
+	return result
+}
+
+// FilterMapHash returns an Array of the results of fn for which it also returns true,
+// in no particular order, like Ruby's Hash#filter_map.
+// Example: FilterMapHash(Hash[string, int]{"a": 1, "b": 2}, func(k string, v int) (string, bool) { return k, v > 1 }) -> ["b"]
+func FilterMapHash[K comparable, V, U any](h Hash[K, V], fn func(K, V) (U, bool)) Array[U] {
+	result := make(Array[U], 0)
+	for k, v := range h {
+		if mapped, ok := fn(k, v); ok {
+			result = append(result, mapped)
+		}
+	}
+	return result
+}
+
+// Invert returns a new Hash with keys and values swapped, keeping the key and value types.
+// When several keys share a value, the largest key wins.
+// Example: Invert(Hash[string, int]{"a": 1, "b": 1}) -> {1: "b"}
+func Invert[K, V comparable](h Hash[K, V]) Hash[V, K] {
+	result, _ := InvertWith(h, KeepLast)
+	return result
+}
+
+// InvertWith is like Invert but resolves keys sharing a value with the given policy.
+// The returned Hash is nil whenever the error is not.
+// Example: InvertWith(Hash[string, int]{"a": 1, "b": 1}, RejectCollision) -> nil, ErrKeyCollision
+func InvertWith[K, V comparable](h Hash[K, V], policy KeyCollision[V, K]) (Hash[V, K], error) {
+	return MapHashWith(h, func(k K, v V) (V, K) { return v, k }, policy)
+}
+
+// SumBy returns the sum of fn applied to each key-value pair of a Hash. Float results are
+// summed with compensated summation, as with Sum.
+// Example: SumBy(Hash[string, Integer]{"a": 1, "b": 2}, func(k string, v Integer) Integer { return v }) -> 3
+func SumBy[K comparable, V any, N Integer | Float](h Hash[K, V], fn func(K, V) N) N {
+	values := make(Array[N], 0, len(h))
+	for k, v := range h {
+		values = append(values, fn(k, v))
+	}
+	return Sum(values)
+}
+
+// GroupByHash groups the key-value pairs of a Hash into Hashes keyed by the result of fn.
+// Example: GroupByHash(Hash[string, int]{"a": 1, "b": 2, "c": 3}, func(k string, v int) bool { return v%2 == 1 }) -> {true: {"a": 1, "c": 3}, false: {"b": 2}}
+func GroupByHash[K, G comparable, V any](h Hash[K, V], fn func(K, V) G) Hash[G, Hash[K, V]] {
+	result := make(Hash[G, Hash[K, V]])
+	for k, v := range h {
+		group := fn(k, v)
+		if result[group] == nil {
+			result[group] = make(Hash[K, V])
+		}
+		result[group][k] = v
+	}
+	return result
+}
+
+// EachWithObjectHash calls fn with each key-value pair and the given object, then returns the object.
+// Example: EachWithObjectHash(Hash[String, Integer]{"a": 2}, Hash[Integer, String]{}, func(k String, v Integer, h Hash[Integer, String]) { h[v] = k }) -> {2: "a"}
+func EachWithObjectHash[K comparable, V, O any](h Hash[K, V], obj O, fn func(K, V, O)) O {
+	for k, v := range h {
+		fn(k, v, obj)
+	}
+	return obj
+}
+
+// Pair represents a key-value pair in a Hash, or two zipped Array elements.
+type Pair[K, V any] struct {
 	Key   K
 	Value V
 }
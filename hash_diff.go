@@ -0,0 +1,157 @@
+// Package rb provides Ruby-inspired utility methods for Go types.
+package rb
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// HashDiff describes how one Hash differs from another, as returned by Compare.
+type HashDiff[K comparable, V any] struct {
+	Added   Hash[K, V]           // pairs only in the other Hash
+	Removed Hash[K, V]           // pairs only in the receiver
+	Changed map[K]ValueChange[V] // keys in both whose values differ
+}
+
+// ValueChange describes a value that differs between two Hashes.
+// When both values are maps of the same type, Nested describes how they differ.
+type ValueChange[V any] struct {
+	Old    V
+	New    V
+	Nested *HashDiff[any, any]
+}
+
+// Equal checks if both Hashes have the same keys with equal values, like Ruby's ==.
+// Values are compared with == when possible and reflect.DeepEqual otherwise,
+// or with eq if it is given.
+// Example: Hash[string, int]{"a": 1}.Equal(Hash[string, int]{"a": 1}) -> true
+func (h Hash[K, V]) Equal(other Hash[K, V], eq ...func(a, b V) bool) Boolean {
+	return len(h) == len(other) && h.IsSubsetOf(other, eq...)
+}
+
+// IsSubsetOf checks if every key of the Hash is in other with an equal value, like Ruby's <=.
+// Values are compared as for Equal.
+// Example: Hash[string, int]{"a": 1}.IsSubsetOf(Hash[string, int]{"a": 1, "b": 2}) -> true
+func (h Hash[K, V]) IsSubsetOf(other Hash[K, V], eq ...func(a, b V) bool) Boolean {
+	if len(h) > len(other) {
+		return false
+	}
+
+	equals := valueEquality(eq)
+	for k, v := range h {
+		if otherValue, exists := other[k]; !exists || !equals(v, otherValue) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSupersetOf checks if every key of other is in the Hash with an equal value, like Ruby's >=.
+// Values are compared as for Equal.
+// Example: Hash[string, int]{"a": 1, "b": 2}.IsSupersetOf(Hash[string, int]{"a": 1}) -> true
+func (h Hash[K, V]) IsSupersetOf(other Hash[K, V], eq ...func(a, b V) bool) Boolean {
+	return other.IsSubsetOf(h, eq...)
+}
+
+// Compare returns the differences from the Hash to other: the pairs added in other, the pairs
+// removed from it and the values changed between them. Values are compared as for Equal, and
+// changed values that are both maps of the same type are compared recursively.
+// Example: Hash[string, int]{"a": 1, "b": 2}.Compare(Hash[string, int]{"b": 3, "c": 4}) -> {Added: {"c": 4}, Removed: {"a": 1}, Changed: {"b": {2 3}}}
+func (h Hash[K, V]) Compare(other Hash[K, V], eq ...func(a, b V) bool) HashDiff[K, V] {
+	equals := valueEquality(eq)
+	diff := HashDiff[K, V]{
+		Added:   make(Hash[K, V]),
+		Removed: make(Hash[K, V]),
+		Changed: make(map[K]ValueChange[V]),
+	}
+
+	for k, v := range h {
+		otherValue, exists := other[k]
+		switch {
+		case !exists:
+			diff.Removed[k] = v
+		case !equals(v, otherValue):
+			diff.Changed[k] = ValueChange[V]{Old: v, New: otherValue, Nested: nestedDiff(v, otherValue)}
+		}
+	}
+	for k, v := range other {
+		if _, exists := h[k]; !exists {
+			diff.Added[k] = v
+		}
+	}
+	return diff
+}
+
+// IsEmpty checks if the HashDiff has no differences.
+func (d HashDiff[K, V]) IsEmpty() Boolean {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// ToS renders the HashDiff as one line per key, sorted by key: "- key: value" for removed
+// pairs, "+ key: value" for added pairs and "~ key: old -> new" for changed values, with
+// the differences of nested maps indented below their key.
+// Example: Hash[string, int]{"a": 1}.Compare(Hash[string, int]{"a": 2}).ToS() -> "~ a: 1 -> 2\n"
+func (d HashDiff[K, V]) ToS() String {
+	var b strings.Builder
+	d.render(&b, "")
+	return String(b.String())
+}
+
+// String implements fmt.Stringer.
+func (d HashDiff[K, V]) String() string {
+	return string(d.ToS())
+}
+
+func (d HashDiff[K, V]) render(b *strings.Builder, indent string) {
+	keys := make(Array[K], 0, len(d.Added)+len(d.Removed)+len(d.Changed))
+	keys = append(keys, d.Removed.Keys()...)
+	keys = append(keys, d.Added.Keys()...)
+	for k := range d.Changed {
+		keys = append(keys, k)
+	}
+
+	keys.EnforceSortWith(compareKeys[K])
+
+	for _, k := range keys {
+		if v, exists := d.Removed[k]; exists {
+			fmt.Fprintf(b, "%s- %v: %v\n", indent, k, v)
+		} else if v, exists := d.Added[k]; exists {
+			fmt.Fprintf(b, "%s+ %v: %v\n", indent, k, v)
+		} else if change := d.Changed[k]; change.Nested != nil {
+			fmt.Fprintf(b, "%s~ %v:\n", indent, k)
+			change.Nested.render(b, indent+"  ")
+		} else {
+			fmt.Fprintf(b, "%s~ %v: %v -> %v\n", indent, k, change.Old, change.New)
+		}
+	}
+}
+
+// valueEquality returns the optional equality function passed to a comparison method,
+// or the default one.
+func valueEquality[V any](eq []func(a, b V) bool) func(a, b V) bool {
+	if len(eq) > 0 && eq[0] != nil {
+		return eq[0]
+	}
+	return equalFor[V]()
+}
+
+// nestedDiff compares two values that are maps of the same type, returning nil for anything else.
+func nestedDiff(a, b any) *HashDiff[any, any] {
+	av, bv := reflect.ValueOf(a), reflect.ValueOf(b)
+	if av.Kind() != reflect.Map || bv.Kind() != reflect.Map || av.Type() != bv.Type() {
+		return nil
+	}
+
+	diff := anyHash(av).Compare(anyHash(bv))
+	return &diff
+}
+
+// anyHash copies a map into a Hash[any, any].
+func anyHash(m reflect.Value) Hash[any, any] {
+	result := make(Hash[any, any], m.Len())
+	for it := m.MapRange(); it.Next(); {
+		result[it.Key().Interface()] = it.Value().Interface()
+	}
+	return result
+}
@@ -0,0 +1,99 @@
+package rb
+
+import (
+	"testing"
+)
+
+func TestHash_Equal(t *testing.T) {
+	hash := Hash[String, Integer]{"a": 1, "b": 2}
+
+	if !hash.Equal(Hash[String, Integer]{"b": 2, "a": 1}) {
+		t.Errorf("Equal() expected true for the same pairs")
+	}
+	if hash.Equal(Hash[String, Integer]{"a": 1, "b": 3}) || hash.Equal(Hash[String, Integer]{"a": 1}) {
+		t.Errorf("Equal() expected false for different pairs")
+	}
+
+	nested := Hash[String, Array[Integer]]{"a": {1, 2}}
+	if !nested.Equal(Hash[String, Array[Integer]]{"a": {1, 2}}) {
+		t.Errorf("Equal() expected true for deeply equal values")
+	}
+
+	caseInsensitive := func(a, b String) bool { return a.Downcase() == b.Downcase() }
+	if !(Hash[Integer, String]{1: "A"}).Equal(Hash[Integer, String]{1: "a"}, caseInsensitive) {
+		t.Errorf("Equal() expected true with a case-insensitive equality function")
+	}
+}
+
+func TestHash_IsSubsetOf(t *testing.T) {
+	small := Hash[String, Integer]{"a": 1}
+	large := Hash[String, Integer]{"a": 1, "b": 2}
+
+	if !small.IsSubsetOf(large) || large.IsSubsetOf(small) || !large.IsSupersetOf(small) || small.IsSupersetOf(large) {
+		t.Errorf("IsSubsetOf()/IsSupersetOf() returned unexpected results")
+	}
+	if (Hash[String, Integer]{"a": 2}).IsSubsetOf(large) {
+		t.Errorf("IsSubsetOf() expected false when a value differs")
+	}
+	if !small.IsSubsetOf(small) || !(Hash[String, Integer]{}).IsSubsetOf(small) {
+		t.Errorf("IsSubsetOf() expected true for itself and the empty Hash")
+	}
+}
+
+func TestHash_Compare(t *testing.T) {
+	expected := Hash[String, Integer]{"a": 1, "b": 2, "c": 3}
+	actual := Hash[String, Integer]{"b": 20, "c": 3, "d": 4}
+
+	diff := expected.Compare(actual)
+	if len(diff.Removed) != 1 || diff.Removed["a"] != 1 {
+		t.Errorf("Compare() expected Removed {a: 1}, got %v", diff.Removed)
+	}
+	if len(diff.Added) != 1 || diff.Added["d"] != 4 {
+		t.Errorf("Compare() expected Added {d: 4}, got %v", diff.Added)
+	}
+	if change, ok := diff.Changed["b"]; len(diff.Changed) != 1 || !ok || change.Old != 2 || change.New != 20 || change.Nested != nil {
+		t.Errorf("Compare() expected Changed {b: 2 -> 20}, got %v", diff.Changed)
+	}
+	if diff.IsEmpty() || !expected.Compare(expected.Clone()).IsEmpty() {
+		t.Errorf("IsEmpty() returned unexpected results")
+	}
+
+	if diff.ToS() != "- a: 1\n~ b: 2 -> 20\n+ d: 4\n" {
+		t.Errorf("ToS() expected a sorted line per key, got %q", diff.ToS())
+	}
+	if expected.Compare(expected).String() != "" {
+		t.Errorf("String() expected empty output for no differences")
+	}
+}
+
+func TestHash_CompareNested(t *testing.T) {
+	expected := Hash[string, any]{
+		"name": "app",
+		"db":   Hash[string, any]{"host": "localhost", "port": 5432, "pool": map[string]int{"min": 1}},
+		"tags": Array[string]{"a"},
+	}
+	actual := Hash[string, any]{
+		"name": "app",
+		"db":   Hash[string, any]{"host": "localhost", "port": 6543, "ssl": true, "pool": map[string]int{"min": 2}},
+		"tags": Array[string]{"a", "b"},
+	}
+
+	diff := expected.Compare(actual)
+	db := diff.Changed["db"].Nested
+	if db == nil || len(db.Changed) != 2 || len(db.Added) != 1 || db.Added["ssl"] != true {
+		t.Fatalf("Compare() expected a nested diff for db, got %v", diff.Changed["db"])
+	}
+	if diff.Changed["tags"].Nested != nil {
+		t.Errorf("Compare() expected no nested diff for slices")
+	}
+
+	rendered := "~ db:\n" +
+		"  ~ pool:\n" +
+		"    ~ min: 1 -> 2\n" +
+		"  ~ port: 5432 -> 6543\n" +
+		"  + ssl: true\n" +
+		"~ tags: [a] -> [a b]\n"
+	if diff.ToS() != String(rendered) {
+		t.Errorf("ToS() expected\n%s\ngot\n%s", rendered, diff.ToS())
+	}
+}
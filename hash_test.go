@@ -1,6 +1,9 @@
 package rb
 
 import (
+	"errors"
+	"fmt"
+	"maps"
 	"testing"
 )
 
@@ -15,7 +18,7 @@ func TestHash_Keys(t *testing.T) {
 	// Check that all keys are present
 	expectedKeys := map[string]bool{"a": true, "b": true, "c": true}
 	for _, key := range result {
-		if !expectedKeys[string(key.(String))] {
+		if !expectedKeys[string(key)] {
 			t.Errorf("Keys() contains unexpected key: %s", key)
 		}
 	}
@@ -32,7 +35,7 @@ func TestHash_Values(t *testing.T) {
 	// Check that all values are present
 	expectedValues := map[int]bool{1: true, 2: true, 3: true}
 	for _, value := range result {
-		if !expectedValues[int(value.(Integer))] {
+		if !expectedValues[int(value)] {
 			t.Errorf("Values() contains unexpected value: %d", value)
 		}
 	}
@@ -87,18 +90,23 @@ func TestHash_Fetch(t *testing.T) {
 	hash := Hash[String, Integer]{"a": 1, "b": 2, "c": 3}
 
 	// Test existing key
-	result := hash.Fetch(String("a"))
-	if result != 1 {
-		t.Errorf("Fetch() for key 'a' expected 1, got %d", result)
+	result, err := hash.Fetch(String("a"))
+	if result != 1 || err != nil {
+		t.Errorf("Fetch() for key 'a' expected 1, got %d (%v)", result, err)
 	}
 
-	// Test non-existing key (should panic)
-	defer func() {
-		if r := recover(); r == nil {
-			t.Error("Fetch() for non-existing key should panic")
-		}
-	}()
-	hash.Fetch(String("d"))
+	// Test non-existing key (should return a *KeyError)
+	result, err = hash.Fetch(String("d"))
+	var keyErr *KeyError
+	if !errors.As(err, &keyErr) {
+		t.Fatalf("Fetch() for non-existing key expected *KeyError, got %v", err)
+	}
+	if keyErr.Key != String("d") || keyErr.Receiver.(Hash[String, Integer])["a"] != 1 || result != 0 {
+		t.Errorf("Fetch() expected KeyError for d on the receiver, got %v", keyErr)
+	}
+	if err.Error() != "rb: key not found: d" {
+		t.Errorf("KeyError.Error() expected 'rb: key not found: d', got %s", err.Error())
+	}
 }
 
 func TestHash_Set(t *testing.T) {
@@ -395,9 +403,8 @@ func TestHash_ToArray(t *testing.T) {
 	// Check that all pairs are present
 	expected := map[string]int{"a": 1, "b": 2, "c": 3}
 	for _, pair := range result {
-		p := pair.(Pair[String, Integer])
-		key := string(p.Key)
-		value := int(p.Value)
+		key := string(pair.Key)
+		value := int(pair.Value)
 
 		if expectedValue, exists := expected[key]; !exists || expectedValue != value {
 			t.Errorf("ToArray() contains unexpected pair: %s -> %d", key, value)
@@ -510,3 +517,398 @@ func TestHash_DeleteIf(t *testing.T) {
 		}
 	}
 }
+
+func TestHash_Seq(t *testing.T) {
+	hash := Hash[String, Integer]{"a": 1, "b": 2, "c": 3}
+
+	total := Integer(0)
+	for _, v := range hash.Seq() {
+		total += v
+	}
+	if total != 6 {
+		t.Errorf("Seq() expected total 6, got %d", total)
+	}
+
+	visited := 0
+	for range hash.Seq() {
+		visited++
+		break
+	}
+	if visited != 1 {
+		t.Errorf("Seq() with break expected 1 visit, got %d", visited)
+	}
+
+	copied := maps.Collect(hash.Seq())
+	if len(copied) != 3 || copied["b"] != 2 {
+		t.Errorf("maps.Collect(Seq()) expected copy of hash, got %v", copied)
+	}
+}
+
+func TestHashFrom(t *testing.T) {
+	source := map[String]Integer{"a": 1, "b": 2}
+	result := HashFrom(maps.All(source))
+
+	if result.Size() != 2 || result["a"] != 1 {
+		t.Errorf("HashFrom() expected {a: 1, b: 2}, got %v", result)
+	}
+
+	doubled := HashFrom(Hash[String, Integer]{"a": 1}.Seq())
+	if !doubled.HasKey("a") {
+		t.Errorf("HashFrom(Seq()) expected key a, got %v", doubled)
+	}
+}
+
+func TestHash_TypedArrays(t *testing.T) {
+	hash := Hash[String, Integer]{"b": 2, "a": 1, "c": 3}
+
+	if hash.Keys().Sort().Join(",") != "a,b,c" {
+		t.Errorf("Keys() expected to chain into Array methods, got %v", hash.Keys())
+	}
+	if hash.Values().Select(func(v Integer) bool { return v > 1 }).Length() != 2 {
+		t.Errorf("Values() expected to chain into Array methods, got %v", hash.Values())
+	}
+	if Sum(hash.Values()) != 6 {
+		t.Errorf("Sum(Values()) expected 6, got %d", Sum(hash.Values()))
+	}
+}
+
+func TestHash_SortedKeys(t *testing.T) {
+	hash := Hash[Integer, String]{10: "ten", 2: "two", 33: "thirty-three"}
+
+	if hash.SortedKeys().Join(",") != "2,10,33" {
+		t.Errorf("SortedKeys() expected 2,10,33, got %s", hash.SortedKeys().Join(","))
+	}
+	if len(Hash[Integer, String]{}.SortedKeys()) != 0 {
+		t.Errorf("SortedKeys() on empty Hash expected empty Array")
+	}
+
+	type point struct{ X, Y int }
+	points := Hash[point, Integer]{{2, 1}: 0, {1, 2}: 0, {1, 1}: 0}
+	for range 20 {
+		if keys := points.SortedKeys(); fmt.Sprint(keys) != "[{1 1} {1 2} {2 1}]" {
+			t.Fatalf("SortedKeys() with struct keys expected [{1 1} {1 2} {2 1}], got %v", keys)
+		}
+	}
+}
+
+func TestHash_Sort(t *testing.T) {
+	hash := Hash[String, Integer]{"b": 1, "c": 3, "a": 2}
+
+	sorted := hash.Sort()
+	if len(sorted) != 3 || sorted[0] != (Pair[String, Integer]{"a", 2}) || sorted[2] != (Pair[String, Integer]{"c", 3}) {
+		t.Errorf("Sort() expected [{a 2} {b 1} {c 3}], got %v", sorted)
+	}
+}
+
+func TestHash_SortBy(t *testing.T) {
+	hash := Hash[String, Integer]{"a": 2, "b": 1, "c": 2, "d": 0}
+
+	sorted := hash.SortBy(func(k String, v Integer) any { return v })
+	keys := MapTo(sorted, func(p Pair[String, Integer]) String { return p.Key })
+	if keys.Join(",") != "d,b,a,c" {
+		t.Errorf("SortBy() expected d,b,a,c, got %s", keys.Join(","))
+	}
+
+	type point struct{ X, Y int }
+	points := Hash[point, Integer]{{2, 1}: 0, {1, 2}: 0, {1, 1}: 0, {0, 3}: 0}
+	for range 20 {
+		sorted := points.SortBy(func(k point, v Integer) any { return v })
+		if keys := MapTo(sorted, func(p Pair[point, Integer]) point { return p.Key }); fmt.Sprint(keys) != "[{0 3} {1 1} {1 2} {2 1}]" {
+			t.Fatalf("SortBy() with struct keys expected [{0 3} {1 1} {1 2} {2 1}], got %v", keys)
+		}
+	}
+}
+
+func TestHash_FetchOr(t *testing.T) {
+	hash := Hash[String, Integer]{"a": 1}
+
+	if hash.FetchOr("a", func(k String) Integer { return 0 }) != 1 {
+		t.Errorf("FetchOr() for key 'a' expected 1")
+	}
+	if hash.FetchOr("abc", func(k String) Integer { return k.Length() }) != 3 {
+		t.Errorf("FetchOr() for missing key expected fallback 3")
+	}
+}
+
+func TestHash_ValuesAt(t *testing.T) {
+	hash := Hash[String, Integer]{"a": 1, "b": 2}
+
+	if hash.ValuesAt("b", "z", "a").Join(",") != "2,0,1" {
+		t.Errorf("ValuesAt() expected 2,0,1, got %v", hash.ValuesAt("b", "z", "a"))
+	}
+}
+
+func TestHash_FetchValues(t *testing.T) {
+	hash := Hash[String, Integer]{"a": 1, "b": 2}
+
+	values, err := hash.FetchValues("b", "a")
+	if err != nil || values.Join(",") != "2,1" {
+		t.Errorf("FetchValues() expected 2,1, got %v (%v)", values, err)
+	}
+
+	values, err = hash.FetchValues("a", "y", "z")
+	var keyErr *KeyError
+	if !errors.As(err, &keyErr) || keyErr.Key != String("y") || values != nil {
+		t.Errorf("FetchValues() expected KeyError for y, got %v (%v)", values, err)
+	}
+}
+
+func TestHash_Dig(t *testing.T) {
+	inner := Hash[String, any]{"name": String("rb"), "tags": Array[String]{"go", "ruby"}}
+	hash := Hash[String, any]{
+		"project": inner,
+		"plain":   map[string]int{"x": 1},
+		"list":    AnyArray{Hash[Integer, String]{7: "seven"}},
+	}
+
+	tests := []struct {
+		path     []any
+		expected any
+	}{
+		{[]any{"name"}, String("rb")},
+		{[]any{"tags", 0}, String("go")},
+		{[]any{"tags", -1}, String("ruby")},
+	}
+	for _, tt := range tests {
+		value, err := hash.Dig("project", tt.path...)
+		if err != nil || value != tt.expected {
+			t.Errorf("Dig(project, %v) expected %v, got %v (%v)", tt.path, tt.expected, value, err)
+		}
+	}
+
+	if value, err := hash.Dig("plain", "x"); err != nil || value != 1 {
+		t.Errorf("Dig(plain, x) expected 1, got %v (%v)", value, err)
+	}
+	if value, err := hash.Dig("list", uint(0), 7); err != nil || value != String("seven") {
+		t.Errorf("Dig(list, 0, 7) expected seven, got %v (%v)", value, err)
+	}
+	if value, err := hash.Dig("project"); err != nil || value.(Hash[String, any])["name"] != String("rb") {
+		t.Errorf("Dig(project) expected the nested Hash, got %v (%v)", value, err)
+	}
+
+	var keyErr *KeyError
+	if _, err := hash.Dig("project", "missing"); !errors.As(err, &keyErr) || keyErr.Key != "missing" {
+		t.Errorf("Dig(project, missing) expected KeyError for missing, got %v", err)
+	}
+	if _, err := hash.Dig("project", "tags", 5); !errors.As(err, &keyErr) || keyErr.Key != 5 {
+		t.Errorf("Dig(project, tags, 5) expected KeyError for 5, got %v", err)
+	}
+	var nilMap map[string]int
+	var nilPointer *Hash[String, any]
+	nils := Hash[String, any]{"nil": nil, "map": nilMap, "slice": Array[int](nil), "pointer": nilPointer}
+	for _, key := range nils.SortedKeys() {
+		if _, err := nils.Dig(key, "b"); !errors.As(err, &keyErr) || keyErr.Key != "b" {
+			t.Errorf("Dig(%s, b) expected KeyError for b, got %v", key, err)
+		}
+	}
+	if _, err := hash.Dig("nope"); !errors.As(err, &keyErr) || keyErr.Key != String("nope") {
+		t.Errorf("Dig(nope) expected KeyError for nope, got %v", err)
+	}
+	if _, err := hash.Dig("project", "name", 0); !errors.Is(err, ErrTypeMismatch) {
+		t.Errorf("Dig(project, name, 0) expected ErrTypeMismatch, got %v", err)
+	}
+	if _, err := hash.Dig("project", "tags", "first"); !errors.Is(err, ErrTypeMismatch) {
+		t.Errorf("Dig(project, tags, first) expected ErrTypeMismatch, got %v", err)
+	}
+	if _, err := hash.Dig("plain", 1); !errors.Is(err, ErrTypeMismatch) {
+		t.Errorf("Dig(plain, 1) expected ErrTypeMismatch, got %v", err)
+	}
+}
+
+func TestTransformKeys(t *testing.T) {
+	hash := Hash[String, Integer]{"a": 1, "b": 2}
+
+	upper := TransformKeys(hash, func(k String) String { return k.Upcase() })
+	if len(upper) != 2 || upper["A"] != 1 || upper["B"] != 2 {
+		t.Errorf("TransformKeys() expected {A: 1, B: 2}, got %v", upper)
+	}
+
+	lengths := TransformKeys(hash, func(k String) Integer { return k.Length() })
+	if len(lengths) != 1 || lengths[1] != 2 {
+		t.Errorf("TransformKeys() expected {1: 2} with the last key winning, got %v", lengths)
+	}
+}
+
+func TestTransformKeysWith(t *testing.T) {
+	hash := Hash[String, Integer]{"a": 1, "A": 2, "b": 3}
+	lower := func(k String) String { return k.Downcase() }
+
+	first, err := TransformKeysWith(hash, lower, KeepFirst)
+	if err != nil || first["a"] != 2 || first["b"] != 3 {
+		t.Errorf("TransformKeysWith(KeepFirst) expected {a: 2, b: 3}, got %v (%v)", first, err)
+	}
+
+	last, err := TransformKeysWith(hash, lower, KeepLast)
+	if err != nil || last["a"] != 1 {
+		t.Errorf("TransformKeysWith(KeepLast) expected {a: 1, b: 3}, got %v (%v)", last, err)
+	}
+
+	merged, err := TransformKeysWith(hash, lower, MergeCollision(func(k String, existing, incoming Integer) Integer {
+		return existing*10 + incoming
+	}))
+	if err != nil || merged["a"] != 21 {
+		t.Errorf("TransformKeysWith(MergeCollision) expected {a: 21, b: 3}, got %v (%v)", merged, err)
+	}
+
+	rejected, err := TransformKeysWith(hash, lower, RejectCollision)
+	if !errors.Is(err, ErrKeyCollision) || rejected != nil {
+		t.Errorf("TransformKeysWith(RejectCollision) expected ErrKeyCollision, got %v (%v)", rejected, err)
+	}
+	if _, err := TransformKeysWith(Hash[String, Integer]{"a": 1}, lower, RejectCollision); err != nil {
+		t.Errorf("TransformKeysWith(RejectCollision) without collisions expected no error, got %v", err)
+	}
+}
+
+func TestTransformValues(t *testing.T) {
+	hash := Hash[String, Integer]{"a": 1, "b": 2}
+
+	result := TransformValues(hash, func(v Integer) String { return v.ToS() })
+	if len(result) != 2 || result["a"] != "1" || result["b"] != "2" {
+		t.Errorf("TransformValues() expected {a: \"1\", b: \"2\"}, got %v", result)
+	}
+}
+
+func TestMapHash(t *testing.T) {
+	hash := Hash[String, Integer]{"a": 1, "bb": 2}
+
+	result := MapHash(hash, func(k String, v Integer) (Integer, Float) { return k.Length(), Float(v) / 2 })
+	if len(result) != 2 || result[1] != 0.5 || result[2] != 1 {
+		t.Errorf("MapHash() expected {1: 0.5, 2: 1}, got %v", result)
+	}
+
+	if _, err := MapHashWith(hash, func(k String, v Integer) (Integer, Integer) { return 0, v }, RejectCollision); !errors.Is(err, ErrKeyCollision) {
+		t.Errorf("MapHashWith(RejectCollision) expected ErrKeyCollision, got %v", err)
+	}
+
+	type point struct{ X, Y int }
+	points := Hash[point, Integer]{{2, 1}: 3, {1, 2}: 2, {1, 1}: 1}
+	for range 20 {
+		first, _ := MapHashWith(points, func(k point, v Integer) (int, Integer) { return 0, v }, KeepFirst)
+		last := MapHash(points, func(k point, v Integer) (int, Integer) { return 0, v })
+		if first[0] != 1 || last[0] != 3 {
+			t.Fatalf("MapHashWith() with struct keys expected 1 first and 3 last, got %d and %d", first[0], last[0])
+		}
+	}
+}
+
+func TestFilterMapHash(t *testing.T) {
+	hash := Hash[String, Integer]{"a": 1, "b": 2, "c": 3}
+
+	result := FilterMapHash(hash, func(k String, v Integer) (String, bool) { return k.Upcase(), bool(v.IsOdd()) })
+	if result.Sort().Join(",") != "A,C" {
+		t.Errorf("FilterMapHash() expected A,C, got %v", result)
+	}
+}
+
+func TestInvert(t *testing.T) {
+	hash := Hash[String, Integer]{"a": 1, "b": 2, "c": 1}
+
+	inverted := Invert(hash)
+	if len(inverted) != 2 || inverted[1] != "c" || inverted[2] != "b" {
+		t.Errorf("Invert() expected {1: c, 2: b}, got %v", inverted)
+	}
+
+	first, err := InvertWith(hash, KeepFirst)
+	if err != nil || first[1] != "a" {
+		t.Errorf("InvertWith(KeepFirst) expected {1: a, 2: b}, got %v (%v)", first, err)
+	}
+	if _, err := InvertWith(hash, RejectCollision); !errors.Is(err, ErrKeyCollision) {
+		t.Errorf("InvertWith(RejectCollision) expected ErrKeyCollision, got %v", err)
+	}
+}
+
+func TestHash_MaxByMinBy(t *testing.T) {
+	scores := Hash[String, Integer]{"ann": 7, "bob": 9, "cid": 9, "dee": 3}
+	byScore := func(k String, v Integer) any { return v }
+
+	if best := scores.MaxBy(byScore); best == nil || best.Key != "bob" || best.Value != 9 {
+		t.Errorf("MaxBy() expected {bob 9}, got %v", best)
+	}
+	if worst := scores.MinBy(byScore); worst == nil || worst.Key != "dee" {
+		t.Errorf("MinBy() expected {dee 3}, got %v", worst)
+	}
+	if (Hash[String, Integer]{}).MaxBy(byScore) != nil {
+		t.Errorf("MaxBy() on empty Hash expected nil")
+	}
+
+	type point struct{ X, Y int }
+	points := Hash[point, Integer]{{2, 1}: 5, {1, 2}: 5, {1, 1}: 5}
+	for range 20 {
+		if best := points.MaxBy(func(k point, v Integer) any { return v }); best == nil || best.Key != (point{1, 1}) {
+			t.Fatalf("MaxBy() with struct keys expected {1 1}, got %v", best)
+		}
+	}
+}
+
+func TestHash_Count(t *testing.T) {
+	hash := Hash[String, Integer]{"a": 1, "b": 2, "c": 3}
+
+	if count := hash.Count(func(k String, v Integer) bool { return v > 1 }); count != 2 {
+		t.Errorf("Count() expected 2, got %d", count)
+	}
+}
+
+func TestHash_Find(t *testing.T) {
+	hash := Hash[String, Integer]{"a": 1, "b": 2}
+
+	if found := hash.Find(func(k String, v Integer) bool { return v == 2 }); found == nil || found.Key != "b" {
+		t.Errorf("Find() expected {b 2}, got %v", found)
+	}
+	if found := hash.Detect(func(k String, v Integer) bool { return v > 5 }); found != nil {
+		t.Errorf("Detect() expected nil, got %v", found)
+	}
+}
+
+func TestHash_AnyAllNone(t *testing.T) {
+	hash := Hash[String, Integer]{"a": 1, "b": 2}
+	positive := func(k String, v Integer) bool { return v > 0 }
+	large := func(k String, v Integer) bool { return v > 1 }
+
+	if !hash.Any(large) || !hash.All(positive) || hash.All(large) || hash.None(large) {
+		t.Errorf("Any()/All()/None() returned unexpected results for %v", hash)
+	}
+	if !(Hash[String, Integer]{}).All(large) || (Hash[String, Integer]{}).Any(positive) {
+		t.Errorf("All() on empty Hash expected true and Any() false")
+	}
+}
+
+func TestHash_Partition(t *testing.T) {
+	hash := Hash[String, Integer]{"a": 1, "b": 2, "c": 3}
+
+	odd, even := hash.Partition(func(k String, v Integer) bool { return bool(v.IsOdd()) })
+	if len(odd) != 2 || odd["a"] != 1 || odd["c"] != 3 || len(even) != 1 || even["b"] != 2 {
+		t.Errorf("Partition() expected {a: 1, c: 3} and {b: 2}, got %v and %v", odd, even)
+	}
+}
+
+func TestSumBy(t *testing.T) {
+	hash := Hash[String, Integer]{"a": 1, "bb": 2}
+
+	if total := SumBy(hash, func(k String, v Integer) Integer { return k.Length() * v }); total != 5 {
+		t.Errorf("SumBy() expected 5, got %d", total)
+	}
+	prices := Hash[String, Float]{"x": 0.1, "y": 0.2, "z": 0.3}
+	if total := SumBy(prices, func(k String, v Float) Float { return v }); total != 0.6 {
+		t.Errorf("SumBy() expected 0.6, got %v", total)
+	}
+}
+
+func TestGroupByHash(t *testing.T) {
+	hash := Hash[String, Integer]{"a": 1, "b": 2, "c": 3}
+
+	groups := GroupByHash(hash, func(k String, v Integer) Boolean { return v.IsOdd() })
+	if len(groups) != 2 || len(groups[true]) != 2 || groups[false]["b"] != 2 {
+		t.Errorf("GroupByHash() expected {true: {a: 1, c: 3}, false: {b: 2}}, got %v", groups)
+	}
+}
+
+func TestEachWithObjectHash(t *testing.T) {
+	hash := Hash[String, Integer]{"a": 1, "b": 2}
+
+	inverted := EachWithObjectHash(hash, Hash[Integer, String]{}, func(k String, v Integer, acc Hash[Integer, String]) {
+		acc[v] = k
+	})
+	if len(inverted) != 2 || inverted[1] != "a" || inverted[2] != "b" {
+		t.Errorf("EachWithObjectHash() expected {1: a, 2: b}, got %v", inverted)
+	}
+}
@@ -120,6 +120,19 @@ func (i Integer) Times(fn func(Integer)) {
 	}
 }
 
+// TryTimes is like Times for callbacks that can fail. By default it stops at the first error
+// and returns it; pass CollectErrors to make every call and return all errors joined.
+// Example: Integer(3).TryTimes(func(i Integer) error { return attempt(i) })
+func (i Integer) TryTimes(fn func(Integer) error, mode ...ErrorMode) error {
+	errs := newErrorCollector(mode)
+	for j := Integer(0); j < i; j++ {
+		if errs.add(fn(j)) {
+			break
+		}
+	}
+	return errs.err()
+}
+
 // Upto iterates from the Integer value up to maxVal (inclusive).
 func (i Integer) Upto(maxVal Integer, fn func(Integer)) {
 	for val := i; val <= maxVal; val++ {
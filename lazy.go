@@ -0,0 +1,224 @@
+// Package rb provides Ruby-inspired utility methods for Go types.
+package rb
+
+import "iter"
+
+// Lazy is a lazily evaluated enumerator, similar to Ruby's Enumerator::Lazy.
+// Chained operations are fused into a single pass and only compute the values
+// that a terminal method such as First or Force actually consumes, so Lazy
+// also works on infinite sources.
+type Lazy[T any] struct {
+	seq iter.Seq[T]
+}
+
+// LazyFrom creates a Lazy enumerator over the values of an iterator.
+// Example: LazyFrom(slices.Values([]Integer{1, 2, 3}))
+func LazyFrom[T any](seq iter.Seq[T]) Lazy[T] {
+	return Lazy[T]{seq: seq}
+}
+
+// Lazy returns a Lazy enumerator over the elements of the Array.
+// Example: Array[Integer]{1, 2, 3}.Lazy().Map(func(i Integer) Integer { return i * 2 }).First(2) -> [2, 4]
+func (a Array[T]) Lazy() Lazy[T] {
+	return LazyFrom(a.Seq())
+}
+
+// Lazy returns a Lazy enumerator over the values in the Range.
+// Example: NewRange(Integer(1), Integer(1000000)).Lazy().Select(func(i Integer) bool { return bool(i.IsPrime()) }).First(3) -> [2, 3, 5]
+func (r Range[T]) Lazy() Lazy[T] {
+	return LazyFrom(r.Seq())
+}
+
+// Lazy returns a Lazy enumerator over the key-value pairs of the Hash.
+// Example: Hash[string, int]{"a": 1}.Lazy().Force() -> [{"a", 1}]
+func (h Hash[K, V]) Lazy() Lazy[Pair[K, V]] {
+	return LazyFrom(func(yield func(Pair[K, V]) bool) {
+		for k, v := range h {
+			if !yield(Pair[K, V]{Key: k, Value: v}) {
+				return
+			}
+		}
+	})
+}
+
+// Seq returns the underlying iterator, for use with range-over-func.
+func (l Lazy[T]) Seq() iter.Seq[T] {
+	return l.seq
+}
+
+// Map lazily applies fn to each value.
+// Example: Array[Integer]{1, 2}.Lazy().Map(func(i Integer) Integer { return i * 2 }).Force() -> [2, 4]
+func (l Lazy[T]) Map(fn func(T) T) Lazy[T] {
+	return LazyMap(l, fn)
+}
+
+// Select lazily keeps the values for which the predicate returns true.
+// Example: Array[Integer]{1, 2, 3}.Lazy().Select(func(i Integer) bool { return i > 1 }).Force() -> [2, 3]
+func (l Lazy[T]) Select(predicate func(T) bool) Lazy[T] {
+	return LazyFrom(func(yield func(T) bool) {
+		for v := range l.seq {
+			if predicate(v) && !yield(v) {
+				return
+			}
+		}
+	})
+}
+
+// Reject lazily drops the values for which the predicate returns true.
+// Example: Array[Integer]{1, 2, 3}.Lazy().Reject(func(i Integer) bool { return i > 1 }).Force() -> [1]
+func (l Lazy[T]) Reject(predicate func(T) bool) Lazy[T] {
+	return l.Select(func(v T) bool { return !predicate(v) })
+}
+
+// TakeWhile lazily passes values through until the predicate first returns false.
+// Example: Array[Integer]{1, 2, 3, 1}.Lazy().TakeWhile(func(i Integer) bool { return i < 3 }).Force() -> [1, 2]
+func (l Lazy[T]) TakeWhile(predicate func(T) bool) Lazy[T] {
+	return LazyFrom(func(yield func(T) bool) {
+		for v := range l.seq {
+			if !predicate(v) || !yield(v) {
+				return
+			}
+		}
+	})
+}
+
+// DropWhile lazily skips values until the predicate first returns false, then passes the rest through.
+// Example: Array[Integer]{1, 2, 3, 1}.Lazy().DropWhile(func(i Integer) bool { return i < 3 }).Force() -> [3, 1]
+func (l Lazy[T]) DropWhile(predicate func(T) bool) Lazy[T] {
+	return LazyFrom(func(yield func(T) bool) {
+		dropping := true
+		for v := range l.seq {
+			if dropping && predicate(v) {
+				continue
+			}
+			dropping = false
+			if !yield(v) {
+				return
+			}
+		}
+	})
+}
+
+// Take lazily passes through at most n values.
+// Example: NewRange(Integer(1), Integer(100)).Lazy().Take(2).Force() -> [1, 2]
+func (l Lazy[T]) Take(n Integer) Lazy[T] {
+	return LazyFrom(func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		taken := Integer(0)
+		for v := range l.seq {
+			if !yield(v) {
+				return
+			}
+			taken++
+			if taken >= n {
+				return
+			}
+		}
+	})
+}
+
+// Drop lazily skips the first n values.
+// Example: Array[Integer]{1, 2, 3}.Lazy().Drop(2).Force() -> [3]
+func (l Lazy[T]) Drop(n Integer) Lazy[T] {
+	return LazyFrom(func(yield func(T) bool) {
+		dropped := Integer(0)
+		for v := range l.seq {
+			if dropped < n {
+				dropped++
+				continue
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	})
+}
+
+// Uniq lazily drops values that have already been seen.
+// Example: Array[Integer]{1, 2, 1, 3}.Lazy().Uniq().Force() -> [1, 2, 3]
+func (l Lazy[T]) Uniq() Lazy[T] {
+	return l.UniqBy(func(v T) any { return v })
+}
+
+// UniqBy lazily drops values whose key, as returned by fn, has already been seen.
+// Example: Array[String]{"a", "A", "b"}.Lazy().UniqBy(func(s String) any { return s.Downcase() }).Force() -> ["a", "b"]
+func (l Lazy[T]) UniqBy(fn func(T) any) Lazy[T] {
+	return LazyFrom(func(yield func(T) bool) {
+		seen := newSeenSet()
+		for v := range l.seq {
+			if seen.add(fn(v)) && !yield(v) {
+				return
+			}
+		}
+	})
+}
+
+// Each calls fn for every value, forcing the enumerator.
+func (l Lazy[T]) Each(fn func(T)) {
+	for v := range l.seq {
+		fn(v)
+	}
+}
+
+// First returns the first n values as an Array, consuming only as much of the source as needed.
+// Example: NewRange(Integer(1), Integer(1000000)).Lazy().First(3) -> [1, 2, 3]
+func (l Lazy[T]) First(n Integer) Array[T] {
+	return l.Take(n).Force()
+}
+
+// Force evaluates the enumerator and returns all values as an Array.
+// It never returns for infinite sources; limit them with Take, TakeWhile or First first.
+// Example: Array[Integer]{1, 2}.Lazy().Force() -> [1, 2]
+func (l Lazy[T]) Force() Array[T] {
+	return ArrayFrom(l.seq)
+}
+
+// ToA is an alias for Force.
+func (l Lazy[T]) ToA() Array[T] {
+	return l.Force()
+}
+
+// LazyMap lazily applies fn to each value, allowing the element type to change.
+// Example: LazyMap(Array[String]{"a", "bb"}.Lazy(), String.Length).Force() -> [1, 2]
+func LazyMap[T, U any](l Lazy[T], fn func(T) U) Lazy[U] {
+	return LazyFrom(func(yield func(U) bool) {
+		for v := range l.seq {
+			if !yield(fn(v)) {
+				return
+			}
+		}
+	})
+}
+
+// LazyZip lazily pairs up the values of two enumerators. The result has the length of a;
+// once b is exhausted its side of each Pair is the zero value.
+// Example: LazyZip(Array[String]{"a", "b"}.Lazy(), NewRange(Integer(1), Integer(9)).Lazy()).Force() -> [{a 1}, {b 2}]
+func LazyZip[T, U any](a Lazy[T], b Lazy[U]) Lazy[Pair[T, U]] {
+	return LazyFrom(func(yield func(Pair[T, U]) bool) {
+		next, stop := iter.Pull(b.seq)
+		defer stop()
+
+		for v := range a.seq {
+			other, _ := next()
+			if !yield(Pair[T, U]{Key: v, Value: other}) {
+				return
+			}
+		}
+	})
+}
+
+// LazyWithIndex lazily pairs each value with its index, starting at 0.
+// Example: LazyWithIndex(Array[String]{"a", "b"}.Lazy()).Force() -> [{0 a}, {1 b}]
+func LazyWithIndex[T any](l Lazy[T]) Lazy[Pair[Integer, T]] {
+	return LazyFrom(func(yield func(Pair[Integer, T]) bool) {
+		idx := Integer(0)
+		for v := range l.seq {
+			if !yield(Pair[Integer, T]{Key: idx, Value: v}) {
+				return
+			}
+			idx++
+		}
+	})
+}
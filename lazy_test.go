@@ -0,0 +1,145 @@
+package rb
+
+import (
+	"testing"
+)
+
+// naturals is an infinite source used to check that Lazy never over-consumes.
+func naturals(consumed *int) Lazy[Integer] {
+	return LazyFrom(func(yield func(Integer) bool) {
+		for i := Integer(1); ; i++ {
+			*consumed++
+			if !yield(i) {
+				return
+			}
+		}
+	})
+}
+
+func TestLazy_Pipeline(t *testing.T) {
+	consumed := 0
+	result := naturals(&consumed).
+		Select(func(i Integer) bool { return bool(i.IsEven()) }).
+		Map(func(i Integer) Integer { return i * i }).
+		First(3)
+
+	if result.Join(",") != "4,16,36" {
+		t.Errorf("Lazy pipeline expected 4,16,36, got %s", result.Join(","))
+	}
+	if consumed != 6 {
+		t.Errorf("Lazy pipeline should consume 6 source values, consumed %d", consumed)
+	}
+}
+
+func TestLazy_FromArrayRangeHash(t *testing.T) {
+	array := Array[Integer]{1, 2, 3}.Lazy().Reject(func(i Integer) bool { return i == 2 }).Force()
+	if array.Join(",") != "1,3" {
+		t.Errorf("Array.Lazy() expected 1,3, got %s", array.Join(","))
+	}
+
+	primes := NewRange(Integer(1), Integer(1_000_000_000)).Lazy().
+		Select(func(i Integer) bool { return bool(i.IsPrime()) }).
+		First(4)
+	if primes.Join(",") != "2,3,5,7" {
+		t.Errorf("Range.Lazy() expected 2,3,5,7, got %s", primes.Join(","))
+	}
+
+	pairs := Hash[String, Integer]{"a": 1, "b": 2}.Lazy().
+		Select(func(p Pair[String, Integer]) bool { return p.Value > 1 }).
+		ToA()
+	if len(pairs) != 1 || pairs[0].Key != "b" {
+		t.Errorf("Hash.Lazy() expected [{b 2}], got %v", pairs)
+	}
+}
+
+func TestLazy_TakeWhileDropWhile(t *testing.T) {
+	consumed := 0
+	taken := naturals(&consumed).TakeWhile(func(i Integer) bool { return i < 4 }).Force()
+	if taken.Join(",") != "1,2,3" {
+		t.Errorf("TakeWhile() expected 1,2,3, got %s", taken.Join(","))
+	}
+	if consumed != 4 {
+		t.Errorf("TakeWhile() should stop after the first failing value, consumed %d", consumed)
+	}
+
+	dropped := Array[Integer]{1, 2, 3, 1}.Lazy().DropWhile(func(i Integer) bool { return i < 3 }).Force()
+	if dropped.Join(",") != "3,1" {
+		t.Errorf("DropWhile() expected 3,1, got %s", dropped.Join(","))
+	}
+}
+
+func TestLazy_TakeDrop(t *testing.T) {
+	consumed := 0
+	result := naturals(&consumed).Drop(2).Take(3).Force()
+	if result.Join(",") != "3,4,5" {
+		t.Errorf("Drop().Take() expected 3,4,5, got %s", result.Join(","))
+	}
+	if consumed != 5 {
+		t.Errorf("Drop().Take() should consume 5 values, consumed %d", consumed)
+	}
+
+	if empty := naturals(&consumed).Take(0).Force(); len(empty) != 0 {
+		t.Errorf("Take(0) expected empty, got %v", empty)
+	}
+}
+
+func TestLazy_Uniq(t *testing.T) {
+	result := Array[Integer]{1, 2, 1, 3, 2}.Lazy().Uniq().Force()
+	if result.Join(",") != "1,2,3" {
+		t.Errorf("Uniq() expected 1,2,3, got %s", result.Join(","))
+	}
+
+	consumed := 0
+	mod := naturals(&consumed).Map(func(i Integer) Integer { return i % 3 }).Uniq().First(3)
+	if mod.Join(",") != "1,2,0" {
+		t.Errorf("Uniq() on infinite source expected 1,2,0, got %s", mod.Join(","))
+	}
+}
+
+func TestLazyMap(t *testing.T) {
+	result := LazyMap(Array[String]{"a", "bb", "ccc"}.Lazy(), String.Length).
+		Select(func(i Integer) bool { return i > 1 }).
+		Force()
+
+	if result.Join(",") != "2,3" {
+		t.Errorf("LazyMap() expected 2,3, got %s", result.Join(","))
+	}
+}
+
+func TestLazyZip(t *testing.T) {
+	consumed := 0
+	result := LazyZip(Array[String]{"a", "b", "c"}.Lazy(), naturals(&consumed)).Force()
+
+	if len(result) != 3 || result[2].Key != "c" || result[2].Value != 3 {
+		t.Errorf("LazyZip() expected [{a 1} {b 2} {c 3}], got %v", result)
+	}
+
+	short := LazyZip(Array[String]{"a", "b"}.Lazy(), Array[Integer]{1}.Lazy()).Force()
+	if len(short) != 2 || short[1].Value != 0 {
+		t.Errorf("LazyZip() expected zero value for exhausted side, got %v", short)
+	}
+}
+
+func TestLazyWithIndex(t *testing.T) {
+	result := LazyWithIndex(Array[String]{"a", "b"}.Lazy()).Force()
+
+	if len(result) != 2 || result[1].Key != 1 || result[1].Value != "b" {
+		t.Errorf("LazyWithIndex() expected [{0 a} {1 b}], got %v", result)
+	}
+}
+
+func TestLazy_Each(t *testing.T) {
+	total := Integer(0)
+	Array[Integer]{1, 2, 3}.Lazy().Each(func(i Integer) { total += i })
+	if total != 6 {
+		t.Errorf("Each() expected total 6, got %d", total)
+	}
+
+	count := 0
+	for range NewRange(Integer(1), Integer(5)).Lazy().Seq() {
+		count++
+	}
+	if count != 5 {
+		t.Errorf("Seq() expected 5 values, got %d", count)
+	}
+}
@@ -0,0 +1,95 @@
+// Package rb provides Ruby-inspired utility methods for Go types.
+package rb
+
+import "iter"
+
+// orderedKeys keeps a set of keys in insertion order with O(1) membership tests
+// and amortized O(1) deletion. Deleted slots are left as tombstones and compacted
+// once they make up half of the slots.
+type orderedKeys[K comparable] struct {
+	slots []orderedSlot[K]
+	pos   map[K]int
+	dead  int
+	head  int // no live slot exists before head
+}
+
+type orderedSlot[K comparable] struct {
+	key  K
+	live bool
+}
+
+func newOrderedKeys[K comparable](capacity int) *orderedKeys[K] {
+	return &orderedKeys[K]{
+		slots: make([]orderedSlot[K], 0, capacity),
+		pos:   make(map[K]int, capacity),
+	}
+}
+
+// add appends key if it is not present and reports whether it was added.
+func (o *orderedKeys[K]) add(key K) bool {
+	if _, ok := o.pos[key]; ok {
+		return false
+	}
+	o.pos[key] = len(o.slots)
+	o.slots = append(o.slots, orderedSlot[K]{key: key, live: true})
+	return true
+}
+
+// remove deletes key if present and reports whether it was removed.
+func (o *orderedKeys[K]) remove(key K) bool {
+	i, ok := o.pos[key]
+	if !ok {
+		return false
+	}
+	delete(o.pos, key)
+	o.slots[i] = orderedSlot[K]{}
+	o.dead++
+
+	if o.dead > len(o.slots)/2 {
+		o.compact()
+	}
+	return true
+}
+
+func (o *orderedKeys[K]) has(key K) bool {
+	_, ok := o.pos[key]
+	return ok
+}
+
+func (o *orderedKeys[K]) len() int {
+	return len(o.pos)
+}
+
+// first returns the oldest live key.
+func (o *orderedKeys[K]) first() (K, bool) {
+	for ; o.head < len(o.slots); o.head++ {
+		if o.slots[o.head].live {
+			return o.slots[o.head].key, true
+		}
+	}
+	var zero K
+	return zero, false
+}
+
+func (o *orderedKeys[K]) seq() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for _, slot := range o.slots[o.head:] {
+			if slot.live && !yield(slot.key) {
+				return
+			}
+		}
+	}
+}
+
+func (o *orderedKeys[K]) compact() {
+	live := make([]orderedSlot[K], 0, len(o.pos))
+	for _, slot := range o.slots {
+		if slot.live {
+			o.pos[slot.key] = len(live)
+			live = append(live, slot)
+		}
+	}
+	o.slots = live
+	o.dead = 0
+	o.head = 0
+}
@@ -0,0 +1,403 @@
+// Package rb provides Ruby-inspired utility methods for Go types.
+package rb
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// OrderedHash is a Hash that remembers insertion order, matching Ruby's Hash iteration
+// guarantees. Keys, Values, Each and JSON output follow the order in which keys were
+// first set; updating an existing key keeps its position, and deleting it forgets it.
+// The zero value is an empty OrderedHash ready to use.
+type OrderedHash[K comparable, V any] struct {
+	keys   *orderedKeys[K]
+	values map[K]V
+}
+
+// NewOrderedHash creates an empty OrderedHash.
+// Example: NewOrderedHash[string, int]().Set("a", 1)
+func NewOrderedHash[K comparable, V any]() *OrderedHash[K, V] {
+	return &OrderedHash[K, V]{keys: newOrderedKeys[K](0), values: make(map[K]V)}
+}
+
+// OrderedHashFrom creates an OrderedHash from key-value pairs, in the given order.
+// Example: OrderedHashFrom(Pair[string, int]{"b", 2}, Pair[string, int]{"a", 1}).Keys() -> ["b", "a"]
+func OrderedHashFrom[K comparable, V any](pairs ...Pair[K, V]) *OrderedHash[K, V] {
+	h := NewOrderedHash[K, V]()
+	for _, p := range pairs {
+		h.Set(p.Key, p.Value)
+	}
+	return h
+}
+
+func (h *OrderedHash[K, V]) init() {
+	if h.keys == nil {
+		h.keys = newOrderedKeys[K](0)
+		h.values = make(map[K]V)
+	}
+}
+
+// Set sets the value for the given key and returns the OrderedHash.
+// A new key is appended; an existing key keeps its position.
+// Example: NewOrderedHash[string, int]().Set("a", 1).Set("b", 2)
+func (h *OrderedHash[K, V]) Set(key K, value V) *OrderedHash[K, V] {
+	h.init()
+	h.keys.add(key)
+	h.values[key] = value
+	return h
+}
+
+// Get retrieves the value for the given key, returning a default value if the key doesn't exist.
+// Example: OrderedHashFrom(Pair[string, int]{"a", 1}).Get("b", 0) -> 0
+func (h *OrderedHash[K, V]) Get(key K, defaultValue V) V {
+	if value, exists := h.values[key]; exists {
+		return value
+	}
+	return defaultValue
+}
+
+// HasKey checks if the OrderedHash contains the given key.
+func (h *OrderedHash[K, V]) HasKey(key K) Boolean {
+	_, exists := h.values[key]
+	return Boolean(exists)
+}
+
+// Delete removes the key-value pair for the given key and returns the value.
+func (h *OrderedHash[K, V]) Delete(key K) V {
+	h.init()
+	value := h.values[key]
+	if h.keys.remove(key) {
+		delete(h.values, key)
+	}
+	return value
+}
+
+// Clear removes all key-value pairs from the OrderedHash.
+func (h *OrderedHash[K, V]) Clear() {
+	h.keys = newOrderedKeys[K](0)
+	h.values = make(map[K]V)
+}
+
+// Size returns the number of key-value pairs in the OrderedHash.
+func (h *OrderedHash[K, V]) Size() Integer {
+	return Integer(len(h.values))
+}
+
+// Length is an alias for Size.
+func (h *OrderedHash[K, V]) Length() Integer {
+	return h.Size()
+}
+
+// IsEmpty checks if the OrderedHash is empty.
+func (h *OrderedHash[K, V]) IsEmpty() Boolean {
+	return Boolean(len(h.values) == 0)
+}
+
+// First returns the oldest key-value pair, or nil if the OrderedHash is empty.
+// Example: OrderedHashFrom(Pair[string, int]{"a", 1}, Pair[string, int]{"b", 2}).First() -> {"a", 1}
+func (h *OrderedHash[K, V]) First() *Pair[K, V] {
+	h.init()
+	key, ok := h.keys.first()
+	if !ok {
+		return nil
+	}
+	return &Pair[K, V]{Key: key, Value: h.values[key]}
+}
+
+// Shift removes and returns the oldest key-value pair, or nil if the OrderedHash is empty.
+// Example: OrderedHashFrom(Pair[string, int]{"a", 1}, Pair[string, int]{"b", 2}).Shift() -> {"a", 1}
+func (h *OrderedHash[K, V]) Shift() *Pair[K, V] {
+	first := h.First()
+	if first != nil {
+		h.Delete(first.Key)
+	}
+	return first
+}
+
+// Seq returns an iterator over the key-value pairs in insertion order, for use with
+// range-over-func and the standard maps package.
+func (h *OrderedHash[K, V]) Seq() iter.Seq2[K, V] {
+	h.init()
+	keys := h.keys.seq()
+	return func(yield func(K, V) bool) {
+		for k := range keys {
+			if !yield(k, h.values[k]) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns an Array of all keys in insertion order.
+// Example: OrderedHashFrom(Pair[string, int]{"b", 2}, Pair[string, int]{"a", 1}).Keys() -> ["b", "a"]
+func (h *OrderedHash[K, V]) Keys() Array[K] {
+	h.init()
+	return ArrayFrom(h.keys.seq())
+}
+
+// Values returns an Array of all values in insertion order.
+// Example: OrderedHashFrom(Pair[string, int]{"b", 2}, Pair[string, int]{"a", 1}).Values() -> [2, 1]
+func (h *OrderedHash[K, V]) Values() Array[V] {
+	result := make(Array[V], 0, len(h.values))
+	for _, v := range h.Seq() {
+		result = append(result, v)
+	}
+	return result
+}
+
+// ToArray converts the OrderedHash to an Array of key-value pairs in insertion order.
+func (h *OrderedHash[K, V]) ToArray() Array[Pair[K, V]] {
+	result := make(Array[Pair[K, V]], 0, len(h.values))
+	for k, v := range h.Seq() {
+		result = append(result, Pair[K, V]{Key: k, Value: v})
+	}
+	return result
+}
+
+// ToHash converts the OrderedHash to an unordered Hash.
+func (h *OrderedHash[K, V]) ToHash() Hash[K, V] {
+	result := make(Hash[K, V], len(h.values))
+	for k, v := range h.values {
+		result[k] = v
+	}
+	return result
+}
+
+// Each applies the given function to each key-value pair in insertion order.
+func (h *OrderedHash[K, V]) Each(fn func(K, V)) {
+	for k, v := range h.Seq() {
+		fn(k, v)
+	}
+}
+
+// EachKey applies the given function to each key in insertion order.
+func (h *OrderedHash[K, V]) EachKey(fn func(K)) {
+	for k := range h.Seq() {
+		fn(k)
+	}
+}
+
+// EachValue applies the given function to each value in insertion order.
+func (h *OrderedHash[K, V]) EachValue(fn func(V)) {
+	for _, v := range h.Seq() {
+		fn(v)
+	}
+}
+
+// Clone returns a shallow copy of the OrderedHash.
+func (h *OrderedHash[K, V]) Clone() *OrderedHash[K, V] {
+	result := NewOrderedHash[K, V]()
+	for k, v := range h.Seq() {
+		result.Set(k, v)
+	}
+	return result
+}
+
+// Merge returns a new OrderedHash with the pairs of other merged in, overwriting existing keys.
+// Existing keys keep their position and new keys are appended in other's order.
+// Example: OrderedHashFrom(Pair[string, int]{"a", 1}).Merge(OrderedHashFrom(Pair[string, int]{"b", 2})) -> {a => 1, b => 2}
+func (h *OrderedHash[K, V]) Merge(other *OrderedHash[K, V]) *OrderedHash[K, V] {
+	result := h.Clone()
+	result.Update(other)
+	return result
+}
+
+// Update merges the pairs of other into the OrderedHash in place.
+func (h *OrderedHash[K, V]) Update(other *OrderedHash[K, V]) {
+	for k, v := range other.Seq() {
+		h.Set(k, v)
+	}
+}
+
+// EnforceMerge is an alias for Update.
+func (h *OrderedHash[K, V]) EnforceMerge(other *OrderedHash[K, V]) {
+	h.Update(other)
+}
+
+// Select returns a new OrderedHash containing the pairs for which the predicate returns true, in order.
+func (h *OrderedHash[K, V]) Select(predicate func(K, V) bool) *OrderedHash[K, V] {
+	result := NewOrderedHash[K, V]()
+	for k, v := range h.Seq() {
+		if predicate(k, v) {
+			result.Set(k, v)
+		}
+	}
+	return result
+}
+
+// Reject returns a new OrderedHash containing the pairs for which the predicate returns false, in order.
+func (h *OrderedHash[K, V]) Reject(predicate func(K, V) bool) *OrderedHash[K, V] {
+	return h.Select(func(k K, v V) bool { return !predicate(k, v) })
+}
+
+// Map transforms the OrderedHash by applying the given function to each pair, in order.
+// When two pairs map to the same key, the later value wins and the key keeps its first position.
+func (h *OrderedHash[K, V]) Map(fn func(K, V) (K, V)) *OrderedHash[K, V] {
+	result := NewOrderedHash[K, V]()
+	for k, v := range h.Seq() {
+		result.Set(fn(k, v))
+	}
+	return result
+}
+
+// KeepIf keeps only the pairs for which the predicate returns true.
+func (h *OrderedHash[K, V]) KeepIf(predicate func(K, V) bool) {
+	h.DeleteIf(func(k K, v V) bool { return !predicate(k, v) })
+}
+
+// DeleteIf deletes the pairs for which the predicate returns true.
+func (h *OrderedHash[K, V]) DeleteIf(predicate func(K, V) bool) {
+	for _, p := range h.ToArray() {
+		if predicate(p.Key, p.Value) {
+			h.Delete(p.Key)
+		}
+	}
+}
+
+// InvertOrdered returns a new OrderedHash with keys and values swapped, in order.
+// When several keys share a value, the last key wins, as with Ruby's invert.
+// Example: InvertOrdered(OrderedHashFrom(Pair[string, int]{"a", 1})) -> {1 => a}
+func InvertOrdered[K, V comparable](h *OrderedHash[K, V]) *OrderedHash[V, K] {
+	result := NewOrderedHash[V, K]()
+	for k, v := range h.Seq() {
+		result.Set(v, k)
+	}
+	return result
+}
+
+// ToS converts the OrderedHash to a String representation in insertion order.
+// Example: OrderedHashFrom(Pair[string, int]{"a", 1}).ToS() -> "{a => 1}"
+func (h *OrderedHash[K, V]) ToS() String {
+	parts := make([]string, 0, len(h.values))
+	for k, v := range h.Seq() {
+		parts = append(parts, fmt.Sprintf("%v => %v", k, v))
+	}
+	return String("{" + strings.Join(parts, ", ") + "}")
+}
+
+// String implements fmt.Stringer.
+func (h *OrderedHash[K, V]) String() string {
+	return string(h.ToS())
+}
+
+// MarshalJSON encodes the OrderedHash as a JSON object with keys in insertion order.
+// Keys must be strings, integers, or implement encoding.TextMarshaler, as with encoding/json maps.
+func (h *OrderedHash[K, V]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	first := true
+	for k, v := range h.Seq() {
+		keyText, err := jsonKey(k)
+		if err != nil {
+			return nil, err
+		}
+		keyJSON, err := json.Marshal(keyText)
+		if err != nil {
+			return nil, err
+		}
+		valueJSON, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+
+		if !first {
+			buf.WriteByte(',')
+		}
+		first = false
+		buf.Write(keyJSON)
+		buf.WriteByte(':')
+		buf.Write(valueJSON)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON decodes a JSON object into the OrderedHash, keeping the order of its keys.
+func (h *OrderedHash[K, V]) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if tok, err := dec.Token(); err != nil {
+		return err
+	} else if tok != json.Delim('{') {
+		return fmt.Errorf("rb: cannot unmarshal %v into OrderedHash", tok)
+	}
+
+	result := NewOrderedHash[K, V]()
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key, err := parseJSONKey[K](tok.(string))
+		if err != nil {
+			return err
+		}
+
+		var value V
+		if err := dec.Decode(&value); err != nil {
+			return err
+		}
+		result.Set(key, value)
+	}
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+
+	*h = *result
+	return nil
+}
+
+// jsonKey converts a map key to its JSON object key text, following encoding/json rules.
+func jsonKey(key any) (string, error) {
+	if tm, ok := key.(encoding.TextMarshaler); ok {
+		text, err := tm.MarshalText()
+		return string(text), err
+	}
+
+	rv := reflect.ValueOf(key)
+	switch {
+	case rv.Kind() == reflect.String:
+		return rv.String(), nil
+	case rv.CanInt():
+		return strconv.FormatInt(rv.Int(), 10), nil
+	case rv.CanUint():
+		return strconv.FormatUint(rv.Uint(), 10), nil
+	}
+	return "", fmt.Errorf("rb: unsupported JSON key type %T", key)
+}
+
+// parseJSONKey converts JSON object key text back to a map key, following encoding/json rules.
+func parseJSONKey[K comparable](text string) (K, error) {
+	var key K
+	if tu, ok := any(&key).(encoding.TextUnmarshaler); ok {
+		err := tu.UnmarshalText([]byte(text))
+		return key, err
+	}
+
+	rv := reflect.ValueOf(&key).Elem()
+	switch {
+	case rv.Kind() == reflect.String:
+		rv.SetString(text)
+	case rv.CanInt():
+		n, err := strconv.ParseInt(text, 10, 64)
+		if err != nil {
+			return key, err
+		}
+		rv.SetInt(n)
+	case rv.CanUint():
+		n, err := strconv.ParseUint(text, 10, 64)
+		if err != nil {
+			return key, err
+		}
+		rv.SetUint(n)
+	default:
+		return key, fmt.Errorf("rb: unsupported JSON key type %T", key)
+	}
+	return key, nil
+}
@@ -0,0 +1,154 @@
+package rb
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestOrderedHash_Order(t *testing.T) {
+	hash := NewOrderedHash[String, Integer]().Set("c", 3).Set("a", 1).Set("b", 2)
+
+	if hash.Keys().Join(",") != "c,a,b" {
+		t.Errorf("Keys() expected c,a,b, got %s", hash.Keys().Join(","))
+	}
+	if hash.Values().Join(",") != "3,1,2" {
+		t.Errorf("Values() expected 3,1,2, got %s", hash.Values().Join(","))
+	}
+
+	hash.Set("c", 30)
+	if hash.Keys().Join(",") != "c,a,b" || hash.Get("c", 0) != 30 {
+		t.Errorf("Set() on existing key expected to keep position, got %s", hash.ToS())
+	}
+
+	hash.Delete("c")
+	hash.Set("c", 3)
+	if hash.Keys().Join(",") != "a,b,c" {
+		t.Errorf("Delete() then Set() expected a,b,c, got %s", hash.Keys().Join(","))
+	}
+}
+
+func TestOrderedHash_ZeroValue(t *testing.T) {
+	var hash OrderedHash[string, int]
+
+	if !hash.IsEmpty() || hash.First() != nil || hash.Shift() != nil {
+		t.Errorf("zero OrderedHash expected to be empty")
+	}
+	hash.Set("a", 1)
+	if hash.Size() != 1 || !hash.HasKey("a") {
+		t.Errorf("Set() on zero OrderedHash expected size 1, got %d", hash.Size())
+	}
+}
+
+func TestOrderedHash_FirstShift(t *testing.T) {
+	hash := OrderedHashFrom(Pair[string, int]{"a", 1}, Pair[string, int]{"b", 2}, Pair[string, int]{"c", 3})
+
+	if first := hash.First(); first == nil || first.Key != "a" || first.Value != 1 {
+		t.Errorf("First() expected {a 1}, got %v", first)
+	}
+	if shifted := hash.Shift(); shifted == nil || shifted.Key != "a" {
+		t.Errorf("Shift() expected {a 1}, got %v", shifted)
+	}
+	if shifted := hash.Shift(); shifted == nil || shifted.Key != "b" {
+		t.Errorf("Shift() expected {b 2}, got %v", shifted)
+	}
+	if hash.Size() != 1 || hash.First().Key != "c" {
+		t.Errorf("Shift() expected {c => 3} to remain, got %s", hash.ToS())
+	}
+}
+
+func TestOrderedHash_Merge(t *testing.T) {
+	hash := OrderedHashFrom(Pair[string, int]{"a", 1}, Pair[string, int]{"b", 2})
+	other := OrderedHashFrom(Pair[string, int]{"c", 3}, Pair[string, int]{"a", 10})
+
+	merged := hash.Merge(other)
+	if merged.ToS() != "{a => 10, b => 2, c => 3}" {
+		t.Errorf("Merge() expected {a => 10, b => 2, c => 3}, got %s", merged.ToS())
+	}
+	if hash.ToS() != "{a => 1, b => 2}" {
+		t.Errorf("Merge() expected receiver unchanged, got %s", hash.ToS())
+	}
+
+	hash.Update(other)
+	if hash.ToS() != merged.ToS() {
+		t.Errorf("Update() expected %s, got %s", merged.ToS(), hash.ToS())
+	}
+}
+
+func TestOrderedHash_SelectRejectMap(t *testing.T) {
+	hash := OrderedHashFrom(Pair[string, int]{"c", 3}, Pair[string, int]{"a", 1}, Pair[string, int]{"b", 2})
+
+	selected := hash.Select(func(k string, v int) bool { return v > 1 })
+	if selected.ToS() != "{c => 3, b => 2}" {
+		t.Errorf("Select() expected {c => 3, b => 2}, got %s", selected.ToS())
+	}
+
+	rejected := hash.Reject(func(k string, v int) bool { return v > 1 })
+	if rejected.ToS() != "{a => 1}" {
+		t.Errorf("Reject() expected {a => 1}, got %s", rejected.ToS())
+	}
+
+	mapped := hash.Map(func(k string, v int) (string, int) { return k + k, v * 10 })
+	if mapped.ToS() != "{cc => 30, aa => 10, bb => 20}" {
+		t.Errorf("Map() expected {cc => 30, aa => 10, bb => 20}, got %s", mapped.ToS())
+	}
+
+	hash.DeleteIf(func(k string, v int) bool { return k == "a" })
+	if hash.ToS() != "{c => 3, b => 2}" {
+		t.Errorf("DeleteIf() expected {c => 3, b => 2}, got %s", hash.ToS())
+	}
+}
+
+func TestInvertOrdered(t *testing.T) {
+	hash := OrderedHashFrom(Pair[string, int]{"a", 1}, Pair[string, int]{"b", 2}, Pair[string, int]{"c", 1})
+
+	inverted := InvertOrdered(hash)
+	if inverted.ToS() != "{1 => c, 2 => b}" {
+		t.Errorf("InvertOrdered() expected {1 => c, 2 => b}, got %s", inverted.ToS())
+	}
+}
+
+func TestOrderedHash_Seq(t *testing.T) {
+	hash := OrderedHashFrom(Pair[string, int]{"b", 2}, Pair[string, int]{"a", 1}, Pair[string, int]{"c", 3})
+
+	keys := Array[string]{}
+	for k := range hash.Seq() {
+		if k == "c" {
+			break
+		}
+		keys = keys.Push(k)
+	}
+	if keys.Join(",") != "b,a" {
+		t.Errorf("Seq() expected b,a, got %s", keys.Join(","))
+	}
+}
+
+func TestOrderedHash_JSON(t *testing.T) {
+	hash := OrderedHashFrom(Pair[string, int]{"z", 1}, Pair[string, int]{"a", 2}, Pair[string, int]{"m", 3})
+
+	data, err := json.Marshal(hash)
+	if err != nil || string(data) != `{"z":1,"a":2,"m":3}` {
+		t.Errorf(`MarshalJSON() expected {"z":1,"a":2,"m":3}, got %s (%v)`, data, err)
+	}
+
+	decoded := NewOrderedHash[string, int]()
+	if err := json.Unmarshal([]byte(`{"y":1,"b":2,"x":3}`), decoded); err != nil {
+		t.Fatalf("UnmarshalJSON() unexpected error: %v", err)
+	}
+	if decoded.ToS() != "{y => 1, b => 2, x => 3}" {
+		t.Errorf("UnmarshalJSON() expected {y => 1, b => 2, x => 3}, got %s", decoded.ToS())
+	}
+
+	numeric := OrderedHashFrom(Pair[Integer, String]{10, "ten"}, Pair[Integer, String]{2, "two"})
+	data, err = json.Marshal(numeric)
+	if err != nil || string(data) != `{"10":"ten","2":"two"}` {
+		t.Errorf(`MarshalJSON() expected {"10":"ten","2":"two"}, got %s (%v)`, data, err)
+	}
+	roundTrip := NewOrderedHash[Integer, String]()
+	if err := json.Unmarshal(data, roundTrip); err != nil || roundTrip.Keys().Join(",") != "10,2" {
+		t.Errorf("UnmarshalJSON() expected keys 10,2, got %s (%v)", roundTrip.Keys().Join(","), err)
+	}
+
+	if err := json.Unmarshal([]byte(`[1, 2]`), decoded); err == nil {
+		t.Errorf("UnmarshalJSON() expected error for non-object input")
+	}
+}
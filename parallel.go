@@ -0,0 +1,133 @@
+// Package rb provides Ruby-inspired utility methods for Go types.
+package rb
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// ParallelMap is like Map but calls fn from up to workers goroutines at once.
+// Results keep the order of the receiver. A workers value of zero or less uses GOMAXPROCS.
+// Example: Array[Integer]{1, 2, 3}.ParallelMap(4, func(i Integer) Integer { return i * 2 }) -> [2, 4, 6]
+func (a Array[T]) ParallelMap(workers Integer, fn func(T) T) Array[T] {
+	result, _ := a.ParallelMapContext(context.Background(), workers, func(_ context.Context, v T) (T, error) {
+		return fn(v), nil
+	})
+	return result
+}
+
+// ParallelSelect is like Select but calls the predicate from up to workers goroutines at once.
+// Selected elements keep the order of the receiver.
+// Example: Array[Integer]{1, 2, 3, 4}.ParallelSelect(4, func(i Integer) bool { return i%2 == 0 }) -> [2, 4]
+func (a Array[T]) ParallelSelect(workers Integer, predicate func(T) bool) Array[T] {
+	result, _ := a.ParallelSelectContext(context.Background(), workers, func(_ context.Context, v T) (bool, error) {
+		return predicate(v), nil
+	})
+	return result
+}
+
+// ParallelEach is like Each but calls fn from up to workers goroutines at once.
+// It returns once every call has finished; calls may happen in any order.
+// Example: Array[String]{"a", "b"}.ParallelEach(2, func(s String) { fmt.Println(s) })
+func (a Array[T]) ParallelEach(workers Integer, fn func(T)) {
+	_ = a.ParallelEachContext(context.Background(), workers, func(_ context.Context, v T) error {
+		fn(v)
+		return nil
+	})
+}
+
+// ParallelMapContext is like ParallelMap for callbacks that can fail.
+// Every element is processed even if some calls fail, and all errors are returned joined
+// with errors.Join in element order. Once ctx is done no new calls are started, and
+// ctx.Err() is included in the returned error if any element was skipped.
+// The returned Array is nil whenever the error is not.
+func (a Array[T]) ParallelMapContext(ctx context.Context, workers Integer, fn func(context.Context, T) (T, error)) (Array[T], error) {
+	return parallelMap(ctx, a, workers, fn)
+}
+
+// ParallelSelectContext is like ParallelSelect for predicates that can fail.
+// Errors and cancellation are reported as for ParallelMapContext.
+func (a Array[T]) ParallelSelectContext(ctx context.Context, workers Integer, predicate func(context.Context, T) (bool, error)) (Array[T], error) {
+	keep, err := parallelMap(ctx, a, workers, predicate)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(Array[T], 0)
+	for i, v := range a {
+		if keep[i] {
+			result = append(result, v)
+		}
+	}
+	return result, nil
+}
+
+// ParallelEachContext is like ParallelEach for callbacks that can fail.
+// Errors and cancellation are reported as for ParallelMapContext.
+func (a Array[T]) ParallelEachContext(ctx context.Context, workers Integer, fn func(context.Context, T) error) error {
+	_, err := parallelMap(ctx, a, workers, func(ctx context.Context, v T) (struct{}, error) {
+		return struct{}{}, fn(ctx, v)
+	})
+	return err
+}
+
+// parallelMap runs fn over a on a bounded pool of goroutines, storing each result at the
+// index of its input. A panic in fn is re-raised in the calling goroutine.
+func parallelMap[T, U any](ctx context.Context, a Array[T], workers Integer, fn func(context.Context, T) (U, error)) (Array[U], error) {
+	if workers <= 0 {
+		workers = Integer(runtime.GOMAXPROCS(0))
+	}
+	if int(workers) > len(a) {
+		workers = Integer(len(a))
+	}
+
+	results := make(Array[U], len(a))
+	errs := make([]error, len(a))
+
+	var (
+		next      atomic.Int64
+		done      atomic.Int64
+		wg        sync.WaitGroup
+		panicOnce sync.Once
+		panicVal  any
+		panicked  atomic.Bool
+	)
+
+	for w := Integer(0); w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					panicOnce.Do(func() { panicVal = r })
+					panicked.Store(true)
+				}
+			}()
+
+			for {
+				i := int(next.Add(1) - 1)
+				if i >= len(a) || ctx.Err() != nil || panicked.Load() {
+					return
+				}
+				results[i], errs[i] = fn(ctx, a[i])
+				done.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if panicVal != nil {
+		panic(panicVal)
+	}
+
+	if int(done.Load()) < len(a) {
+		errs = append(errs, ctx.Err())
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
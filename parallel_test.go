@@ -0,0 +1,161 @@
+package rb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestArray_ParallelMap(t *testing.T) {
+	array := NewRange(Integer(1), Integer(1000)).ToArray()
+	result := array.ParallelMap(8, func(i Integer) Integer { return i * 2 })
+
+	if len(result) != len(array) {
+		t.Fatalf("ParallelMap() expected length %d, got %d", len(array), len(result))
+	}
+	for i, v := range result {
+		if v != array[i]*2 {
+			t.Fatalf("ParallelMap() at index %d expected %d, got %d", i, array[i]*2, v)
+		}
+	}
+
+	if empty := (Array[Integer]{}).ParallelMap(4, func(i Integer) Integer { return i }); len(empty) != 0 {
+		t.Errorf("ParallelMap() on empty Array expected empty result, got %v", empty)
+	}
+}
+
+func TestArray_ParallelMapBoundsWorkers(t *testing.T) {
+	var running, peak atomic.Int64
+	array := NewRange(Integer(1), Integer(50)).ToArray()
+
+	array.ParallelMap(3, func(i Integer) Integer {
+		n := running.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		running.Add(-1)
+		return i
+	})
+
+	if peak.Load() > 3 {
+		t.Errorf("ParallelMap(3) ran %d callbacks at once", peak.Load())
+	}
+}
+
+func TestArray_ParallelSelect(t *testing.T) {
+	array := NewRange(Integer(1), Integer(100)).ToArray()
+	result := array.ParallelSelect(0, func(i Integer) bool { return bool(i.IsPrime()) })
+	expected := array.Select(func(i Integer) bool { return bool(i.IsPrime()) })
+
+	if result.Join(",") != expected.Join(",") {
+		t.Errorf("ParallelSelect() expected %s, got %s", expected.Join(","), result.Join(","))
+	}
+}
+
+func TestArray_ParallelEach(t *testing.T) {
+	var mu sync.Mutex
+	seen := Hash[Integer, bool]{}
+
+	NewRange(Integer(1), Integer(100)).ToArray().ParallelEach(4, func(i Integer) {
+		mu.Lock()
+		defer mu.Unlock()
+		seen[i] = true
+	})
+
+	if seen.Size() != 100 {
+		t.Errorf("ParallelEach() expected 100 calls, got %d", seen.Size())
+	}
+}
+
+func TestArray_ParallelMapContext_Errors(t *testing.T) {
+	array := Array[Integer]{1, 2, 3, 4}
+	var calls atomic.Int64
+
+	result, err := array.ParallelMapContext(context.Background(), 2, func(_ context.Context, i Integer) (Integer, error) {
+		calls.Add(1)
+		if i.IsEven() {
+			return 0, fmt.Errorf("bad %d", i)
+		}
+		return i, nil
+	})
+
+	if result != nil {
+		t.Errorf("ParallelMapContext() expected nil result on error, got %v", result)
+	}
+	if err == nil || err.Error() != "bad 2\nbad 4" {
+		t.Errorf("ParallelMapContext() expected joined errors in order, got %v", err)
+	}
+	if calls.Load() != 4 {
+		t.Errorf("ParallelMapContext() should process every element, got %d calls", calls.Load())
+	}
+
+	ok, err := array.ParallelSelectContext(context.Background(), 2, func(_ context.Context, i Integer) (bool, error) {
+		return i > 2, nil
+	})
+	if err != nil || ok.Join(",") != "3,4" {
+		t.Errorf("ParallelSelectContext() expected 3,4, got %v (%v)", ok, err)
+	}
+}
+
+func TestArray_ParallelEachContext_Cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var calls atomic.Int64
+
+	err := NewRange(Integer(1), Integer(1000)).ToArray().ParallelEachContext(ctx, 2, func(_ context.Context, i Integer) error {
+		if calls.Add(1) == 5 {
+			cancel()
+		}
+		return nil
+	})
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("ParallelEachContext() expected context.Canceled, got %v", err)
+	}
+	if calls.Load() >= 1000 {
+		t.Errorf("ParallelEachContext() should stop starting work after cancel, got %d calls", calls.Load())
+	}
+}
+
+func TestArray_ParallelMap_Panic(t *testing.T) {
+	defer func() {
+		if r := recover(); r != "boom" {
+			t.Errorf("ParallelMap() expected panic to propagate, got %v", r)
+		}
+	}()
+
+	Array[Integer]{1, 2, 3}.ParallelMap(2, func(i Integer) Integer {
+		if i == 2 {
+			panic("boom")
+		}
+		return i
+	})
+}
+
+func TestArray_ParallelMap_PanicStopsWorkers(t *testing.T) {
+	var calls atomic.Int64
+	defer func() {
+		if r := recover(); r != "boom" {
+			t.Errorf("ParallelMap() expected panic to propagate, got %v", r)
+		}
+		if n := calls.Load(); n > 10 {
+			t.Errorf("ParallelMap() expected workers to stop after a panic, got %d calls", n)
+		}
+	}()
+
+	NewRange(Integer(1), Integer(1000)).ToArray().ParallelMap(2, func(i Integer) Integer {
+		calls.Add(1)
+		if i == 1 {
+			panic("boom")
+		}
+		time.Sleep(time.Millisecond)
+		return i
+	})
+}
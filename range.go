@@ -2,14 +2,29 @@
 package rb
 
 import (
+	"errors"
 	"fmt"
+	"iter"
+	"math"
 )
 
+// ErrUnboundedRange is the panic value, wrapped with details, of Range operations that
+// cannot work on an endless or beginless Range, like Ruby's RangeError. TryEach and
+// TryStep return it as an error instead.
+var ErrUnboundedRange = errors.New("rb: range is unbounded")
+
+// InfiniteSize is the Size of an endless or beginless Range.
+const InfiniteSize = Integer(math.MaxInt)
+
 // Range represents a range of values, similar to Ruby's Range class.
+// An endless Range has no End, like Ruby's (1..), and a beginless Range has no Begin,
+// like Ruby's (..10); unbounded Ranges are always ascending.
 type Range[T Integer | Float] struct {
 	Begin     T
 	End       T
 	Exclusive bool // true for exclusive range (..), false for inclusive range (...)
+	Beginless bool // true if the Range has no Begin, which is then ignored
+	Endless   bool // true if the Range has no End, which is then ignored
 }
 
 // NewRange creates a new inclusive Range.
@@ -24,22 +39,107 @@ func NewExclusiveRange[T Integer | Float](begin, end T) Range[T] {
 	return Range[T]{Begin: begin, End: end, Exclusive: true}
 }
 
+// NewEndlessRange creates a new Range with no end.
+// Example: NewEndlessRange(Integer(1)) -> 1..
+func NewEndlessRange[T Integer | Float](begin T) Range[T] {
+	return Range[T]{Begin: begin, Endless: true}
+}
+
+// NewBeginlessRange creates a new inclusive Range with no beginning.
+// Example: NewBeginlessRange(Integer(10)) -> ..10
+func NewBeginlessRange[T Integer | Float](end T) Range[T] {
+	return Range[T]{End: end, Beginless: true}
+}
+
+// NewExclusiveBeginlessRange creates a new exclusive Range with no beginning.
+// Example: NewExclusiveBeginlessRange(Integer(10)) -> ...10
+func NewExclusiveBeginlessRange[T Integer | Float](end T) Range[T] {
+	return Range[T]{End: end, Exclusive: true, Beginless: true}
+}
+
+// IsEndless checks if the Range has no end.
+// Example: NewEndlessRange(Integer(1)).IsEndless() -> true
+func (r Range[T]) IsEndless() Boolean {
+	return Boolean(r.Endless)
+}
+
+// IsBeginless checks if the Range has no beginning.
+// Example: NewBeginlessRange(Integer(10)).IsBeginless() -> true
+func (r Range[T]) IsBeginless() Boolean {
+	return Boolean(r.Beginless)
+}
+
+// IsBounded checks if the Range has both a beginning and an end.
+// Example: NewRange(Integer(1), Integer(5)).IsBounded() -> true
+func (r Range[T]) IsBounded() Boolean {
+	return Boolean(!r.Beginless && !r.Endless)
+}
+
+func (r Range[T]) ascending() bool {
+	return r.Beginless || r.Endless || r.Begin <= r.End
+}
+
+// unbounded panics with ErrUnboundedRange if the Range lacks the bound that an operation needs.
+func (r Range[T]) unbounded(missingBegin, missingEnd bool, operation string) {
+	if err := r.unboundedError(missingBegin, missingEnd, operation); err != nil {
+		panic(err)
+	}
+}
+
+// unboundedError returns the error wrapping ErrUnboundedRange that unbounded panics with, or nil.
+func (r Range[T]) unboundedError(missingBegin, missingEnd bool, operation string) error {
+	if missingBegin {
+		return fmt.Errorf("%w: %s is not supported on beginless range %s", ErrUnboundedRange, operation, r.ToS())
+	}
+	if missingEnd {
+		return fmt.Errorf("%w: %s is not supported on endless range %s", ErrUnboundedRange, operation, r.ToS())
+	}
+	return nil
+}
+
 // Each executes the given function for each value in the Range.
+// An endless Range only stops at the limit of T, as for Seq; use Seq to break early.
+// A beginless Range panics with ErrUnboundedRange.
 // Example: NewRange(Integer(1), Integer(3)).Each(func(i Integer) { fmt.Println(i) })
 func (r Range[T]) Each(fn func(T)) {
-	if r.Begin <= r.End {
-		for i := r.Begin; i <= r.End; i++ {
-			if r.Exclusive && i == r.End {
-				break
+	for v := range r.Seq() {
+		fn(v)
+	}
+}
+
+// Seq returns an iterator over the values in the Range, for use with range-over-func.
+// Unlike Each, the loop can break or return early, which also makes it suitable for an
+// endless Range. An endless Range stops at the last value before T would overflow, or
+// for Float stop changing. Iterating a beginless Range panics with ErrUnboundedRange.
+// Example: for i := range NewRange(Integer(1), Integer(3)).Seq() { fmt.Println(i) }
+func (r Range[T]) Seq() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		r.unbounded(r.Beginless, false, "iteration")
+
+		if r.Endless {
+			for i, ok := r.Begin, true; ok; i, ok = advance(i, 1) {
+				if !yield(i) {
+					return
+				}
 			}
-			fn(i)
-		}
-	} else {
-		for i := r.Begin; i >= r.End; i-- {
-			if r.Exclusive && i == r.End {
-				break
+		} else if r.Begin <= r.End {
+			for i := r.Begin; i <= r.End; i++ {
+				if r.Exclusive && i == r.End {
+					break
+				}
+				if !yield(i) {
+					return
+				}
+			}
+		} else {
+			for i := r.Begin; i >= r.End; i-- {
+				if r.Exclusive && i == r.End {
+					break
+				}
+				if !yield(i) {
+					return
+				}
 			}
-			fn(i)
 		}
 	}
 }
@@ -48,28 +148,21 @@ func (r Range[T]) Each(fn func(T)) {
 // Example: NewRange(Integer(1), Integer(3)).EachWithIndex(func(i, idx Integer) { fmt.Printf("%d: %d\n", idx, i) })
 func (r Range[T]) EachWithIndex(fn func(T, Integer)) {
 	idx := Integer(0)
-	if r.Begin <= r.End {
-		for i := r.Begin; i <= r.End; i++ {
-			if r.Exclusive && i == r.End {
-				break
-			}
-			fn(i, idx)
-			idx++
-		}
-	} else {
-		for i := r.Begin; i >= r.End; i-- {
-			if r.Exclusive && i == r.End {
-				break
-			}
-			fn(i, idx)
-			idx++
-		}
+	for i := range r.Seq() {
+		fn(i, idx)
+		idx++
 	}
 }
 
 // Include checks if the given value is included in the Range.
 // Example: NewRange(Integer(1), Integer(5)).Include(Integer(3)) -> true
 func (r Range[T]) Include(value T) Boolean {
+	if r.Beginless || r.Endless {
+		afterBegin := r.Beginless || value >= r.Begin
+		beforeEnd := r.Endless || value < r.End || (!r.Exclusive && value == r.End)
+		return Boolean(afterBegin && beforeEnd)
+	}
+
 	if r.Begin <= r.End {
 		if r.Exclusive {
 			return Boolean(value >= r.Begin && value < r.End)
@@ -90,32 +183,94 @@ func (r Range[T]) Cover(value T) Boolean {
 }
 
 // Step executes the given function for each value in the Range, incrementing by the given step.
+// A beginless Range, or an endless Range with a negative step, panics with ErrUnboundedRange.
 // Example: NewRange(Integer(0), Integer(10)).Step(Integer(2), func(i Integer) { fmt.Println(i) })
 func (r Range[T]) Step(step T, fn func(T)) {
-	if step == 0 {
-		return
+	for v := range r.stepSeq(step) {
+		fn(v)
 	}
+}
 
-	if r.Begin <= r.End {
-		for i := r.Begin; i <= r.End; i += step {
-			if r.Exclusive && i >= r.End {
-				break
-			}
-			fn(i)
+// TryEach is like Each for callbacks that can fail. By default it stops at the first error
+// and returns it; pass CollectErrors to visit every value and return all errors joined.
+// A beginless Range returns an error wrapping ErrUnboundedRange without calling fn.
+// Example: NewRange(Integer(1), Integer(3)).TryEach(func(i Integer) error { return fetchPage(i) })
+func (r Range[T]) TryEach(fn func(T) error, mode ...ErrorMode) error {
+	if err := r.unboundedError(r.Beginless, false, "iteration"); err != nil {
+		return err
+	}
+	return tryEachSeq(r.Seq(), fn, mode)
+}
+
+// TryStep is like Step for callbacks that can fail, with errors handled as for TryEach.
+// Where Step panics with ErrUnboundedRange, TryStep returns it without calling fn.
+// Example: NewRange(Integer(0), Integer(10)).TryStep(Integer(5), func(i Integer) error { return fetchOffset(i) })
+func (r Range[T]) TryStep(step T, fn func(T) error, mode ...ErrorMode) error {
+	if err := r.stepError(step); err != nil {
+		return err
+	}
+	return tryEachSeq(r.stepSeq(step), fn, mode)
+}
+
+// stepError returns the error wrapping ErrUnboundedRange for stepping through the Range by
+// step, or nil. Like Ruby, an endless Range cannot be stepped backwards.
+func (r Range[T]) stepError(step T) error {
+	if err := r.unboundedError(r.Beginless, false, "iteration"); err != nil {
+		return err
+	}
+	return r.unboundedError(false, r.Endless && step < 0, "negative step")
+}
+
+func (r Range[T]) stepSeq(step T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if step == 0 {
+			return
 		}
-	} else {
-		for i := r.Begin; i >= r.End; i -= step {
-			if r.Exclusive && i <= r.End {
-				break
+		if err := r.stepError(step); err != nil {
+			panic(err)
+		}
+
+		if r.Endless {
+			for i, ok := r.Begin, true; ok; i, ok = advance(i, step) {
+				if !yield(i) {
+					return
+				}
+			}
+		} else if r.Begin <= r.End {
+			for i := r.Begin; i <= r.End; i += step {
+				if r.Exclusive && i >= r.End {
+					break
+				}
+				if !yield(i) {
+					return
+				}
+			}
+		} else {
+			for i := r.Begin; i >= r.End; i -= step {
+				if r.Exclusive && i <= r.End {
+					break
+				}
+				if !yield(i) {
+					return
+				}
 			}
-			fn(i)
 		}
 	}
 }
 
+// advance returns i moved up by a positive step and whether that increased it without
+// overflowing T, which ends an endless Range.
+func advance[T Integer | Float](i, step T) (T, bool) {
+	next := i + step
+	return next, next > i && !math.IsInf(float64(next), 0)
+}
+
 // ToArray converts the Range to an Array.
+// It panics with ErrUnboundedRange for an endless or beginless Range.
 // Example: NewRange(Integer(1), Integer(3)).ToArray() -> [1, 2, 3]
 func (r Range[T]) ToArray() Array[T] {
+	r.unbounded(r.Beginless, r.Endless, "ToArray")
+
 	var result []T
 
 	if r.Begin <= r.End {
@@ -137,9 +292,13 @@ func (r Range[T]) ToArray() Array[T] {
 	return Array[T](result)
 }
 
-// Size returns the number of values in the Range.
+// Size returns the number of values in the Range, or InfiniteSize for an endless or beginless Range.
 // Example: NewRange(Integer(1), Integer(5)).Size() -> 5
 func (r Range[T]) Size() Integer {
+	if r.Beginless || r.Endless {
+		return InfiniteSize
+	}
+
 	if r.Begin <= r.End {
 		if r.Exclusive {
 			return Integer(r.End - r.Begin)
@@ -161,6 +320,9 @@ func (r Range[T]) Length() Integer {
 // IsEmpty checks if the Range is empty.
 // Example: NewRange(Integer(5), Integer(1)).IsEmpty() -> true
 func (r Range[T]) IsEmpty() Boolean {
+	if r.Beginless || r.Endless {
+		return false
+	}
 	if r.Begin > r.End {
 		return Boolean(true)
 	}
@@ -168,34 +330,42 @@ func (r Range[T]) IsEmpty() Boolean {
 }
 
 // Min returns the minimum value in the Range.
+// It panics with ErrUnboundedRange for a beginless Range.
 // Example: NewRange(Integer(1), Integer(5)).Min() -> 1
 func (r Range[T]) Min() T {
-	if r.Begin <= r.End {
+	r.unbounded(r.Beginless, false, "Min")
+	if r.ascending() {
 		return r.Begin
 	}
 	return r.End
 }
 
 // Max returns the maximum value in the Range.
+// It panics with ErrUnboundedRange for an endless Range.
 // Example: NewRange(Integer(1), Integer(5)).Max() -> 5
 func (r Range[T]) Max() T {
-	if r.Begin <= r.End {
+	r.unbounded(false, r.Endless, "Max")
+	if r.ascending() {
 		return r.End
 	}
 	return r.Begin
 }
 
 // First returns the first value in the Range.
+// It panics with ErrUnboundedRange for a beginless Range.
 // Example: NewRange(Integer(1), Integer(5)).First() -> 1
 func (r Range[T]) First() T {
+	r.unbounded(r.Beginless, false, "First")
 	return r.Begin
 }
 
 // Last returns the last value in the Range.
+// It panics with ErrUnboundedRange for an endless Range.
 // Example: NewRange(Integer(1), Integer(5)).Last() -> 5
 func (r Range[T]) Last() T {
+	r.unbounded(false, r.Endless, "Last")
 	if r.Exclusive {
-		if r.Begin <= r.End {
+		if r.ascending() {
 			return r.End - 1
 		}
 		return r.End + 1
@@ -203,12 +373,12 @@ func (r Range[T]) Last() T {
 	return r.End
 }
 
-// BeginValue returns the beginning value of the Range.
+// BeginValue returns the beginning value of the Range, or the zero value for a beginless Range.
 func (r Range[T]) BeginValue() T {
 	return r.Begin
 }
 
-// EndValue returns the end value of the Range.
+// EndValue returns the end value of the Range, or the zero value for an endless Range.
 func (r Range[T]) EndValue() T {
 	return r.End
 }
@@ -226,8 +396,10 @@ func (r Range[T]) IsInclusive() Boolean {
 }
 
 // Reverse returns a new Range with begin and end values swapped.
+// It panics with ErrUnboundedRange for an endless or beginless Range.
 // Example: NewRange(Integer(1), Integer(5)).Reverse() -> 5..1
 func (r Range[T]) Reverse() Range[T] {
+	r.unbounded(r.Beginless, r.Endless, "Reverse")
 	return Range[T]{
 		Begin:     r.End,
 		End:       r.Begin,
@@ -238,6 +410,14 @@ func (r Range[T]) Reverse() Range[T] {
 // Overlap checks if this Range overlaps with another Range.
 // Example: NewRange(Integer(1), Integer(5)).Overlap(NewRange(Integer(3), Integer(7))) -> true
 func (r Range[T]) Overlap(other Range[T]) Boolean {
+	if !r.IsBounded() || !other.IsBounded() {
+		if !r.ascending() || !other.ascending() {
+			return false
+		}
+		return Boolean((r.Beginless || other.Endless || r.Begin <= other.End) &&
+			(other.Beginless || r.Endless || other.Begin <= r.End))
+	}
+
 	if r.Begin <= r.End && other.Begin <= other.End {
 		return Boolean(r.Begin <= other.End && other.Begin <= r.End)
 	} else if r.Begin > r.End && other.Begin > other.End {
@@ -249,6 +429,15 @@ func (r Range[T]) Overlap(other Range[T]) Boolean {
 // Contains checks if this Range completely contains another Range.
 // Example: NewRange(Integer(1), Integer(10)).Contains(NewRange(Integer(3), Integer(7))) -> true
 func (r Range[T]) Contains(other Range[T]) Boolean {
+	if !r.IsBounded() || !other.IsBounded() {
+		if !r.ascending() || !other.ascending() {
+			return false
+		}
+		coversBegin := r.Beginless || (!other.Beginless && r.Begin <= other.Begin)
+		coversEnd := r.Endless || (!other.Endless && r.End >= other.End)
+		return Boolean(coversBegin && coversEnd)
+	}
+
 	if r.Begin <= r.End && other.Begin <= other.End {
 		return Boolean(r.Begin <= other.Begin && r.End >= other.End)
 	} else if r.Begin > r.End && other.Begin > other.End {
@@ -260,10 +449,17 @@ func (r Range[T]) Contains(other Range[T]) Boolean {
 // ToS converts the Range to a String representation.
 // Example: NewRange(Integer(1), Integer(5)).ToS() -> "1..5"
 func (r Range[T]) ToS() String {
+	begin, end, dots := fmt.Sprint(r.Begin), fmt.Sprint(r.End), ".."
 	if r.Exclusive {
-		return String(fmt.Sprintf("%v...%v", r.Begin, r.End))
+		dots = "..."
+	}
+	if r.Beginless {
+		begin = ""
+	}
+	if r.Endless {
+		end = ""
 	}
-	return String(fmt.Sprintf("%v..%v", r.Begin, r.End))
+	return String(begin + dots + end)
 }
 
 // ToStr is an alias for ToS.
@@ -278,5 +474,7 @@ func (r Range[T]) Clone() Range[T] {
 		Begin:     r.Begin,
 		End:       r.End,
 		Exclusive: r.Exclusive,
+		Beginless: r.Beginless,
+		Endless:   r.Endless,
 	}
 }
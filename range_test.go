@@ -1,6 +1,9 @@
 package rb
 
 import (
+	"errors"
+	"math"
+	"slices"
 	"testing"
 )
 
@@ -399,3 +402,203 @@ func TestRange_Float(t *testing.T) {
 		t.Errorf("Float range Each() expected length %d, got %d", len(expected), len(result))
 	}
 }
+
+func TestRange_Seq(t *testing.T) {
+	// A huge range must short-circuit instead of visiting every value
+	huge := NewRange(Integer(1), Integer(1_000_000_000))
+	var found Integer
+	for i := range huge.Seq() {
+		if i*i > 50 {
+			found = i
+			break
+		}
+	}
+	if found != 8 {
+		t.Errorf("Seq() with break expected 8, got %d", found)
+	}
+
+	values := slices.Collect(NewExclusiveRange(Integer(3), Integer(0)).Seq())
+	if len(values) != 3 || values[0] != 3 || values[2] != 1 {
+		t.Errorf("Seq() on descending exclusive range expected [3 2 1], got %v", values)
+	}
+}
+
+func TestRange_Unbounded(t *testing.T) {
+	endless := NewEndlessRange(Integer(1))
+	beginless := NewBeginlessRange(Integer(10))
+	exclusiveBeginless := NewExclusiveBeginlessRange(Integer(10))
+
+	if !endless.IsEndless() || endless.IsBeginless() || endless.IsBounded() || !beginless.IsBeginless() || !NewRange(Integer(1), Integer(2)).IsBounded() {
+		t.Errorf("IsEndless()/IsBeginless()/IsBounded() returned unexpected results")
+	}
+
+	tests := []struct {
+		name     string
+		r        Range[Integer]
+		value    Integer
+		expected Boolean
+	}{
+		{"1.. includes 1", endless, 1, true},
+		{"1.. includes 1000000", endless, 1_000_000, true},
+		{"1.. excludes 0", endless, 0, false},
+		{"..10 includes 10", beginless, 10, true},
+		{"..10 includes -1000000", beginless, -1_000_000, true},
+		{"..10 excludes 11", beginless, 11, false},
+		{"...10 excludes 10", exclusiveBeginless, 10, false},
+		{"...10 includes 9", exclusiveBeginless, 9, true},
+		{"..-5 includes -5", NewBeginlessRange(Integer(-5)), -5, true},
+	}
+	for _, tt := range tests {
+		if result := tt.r.Include(tt.value); result != tt.expected {
+			t.Errorf("Include() %s: expected %t, got %t", tt.name, tt.expected, result)
+		}
+		if result := tt.r.Cover(tt.value); result != tt.expected {
+			t.Errorf("Cover() %s: expected %t, got %t", tt.name, tt.expected, result)
+		}
+	}
+}
+
+func TestRange_UnboundedToS(t *testing.T) {
+	tests := []struct {
+		r        Range[Integer]
+		expected String
+	}{
+		{NewEndlessRange(Integer(1)), "1.."},
+		{Range[Integer]{Begin: 1, Exclusive: true, Endless: true}, "1..."},
+		{NewBeginlessRange(Integer(10)), "..10"},
+		{NewExclusiveBeginlessRange(Integer(10)), "...10"},
+	}
+	for _, tt := range tests {
+		if result := tt.r.ToS(); result != tt.expected {
+			t.Errorf("ToS() expected %s, got %s", tt.expected, result)
+		}
+	}
+}
+
+func TestRange_UnboundedIteration(t *testing.T) {
+	endless := NewEndlessRange(Integer(1))
+
+	result := Array[Integer]{}
+	for i := range endless.Seq() {
+		if i > 3 {
+			break
+		}
+		result = append(result, i)
+	}
+	if result.Join(",") != "1,2,3" {
+		t.Errorf("Seq() on endless Range expected 1,2,3, got %v", result)
+	}
+
+	squares := endless.Lazy().Map(func(i Integer) Integer { return i * i }).First(3)
+	if squares.Join(",") != "1,4,9" {
+		t.Errorf("Lazy() on endless Range expected 1,4,9, got %v", squares)
+	}
+
+	stepped := Array[Integer]{}
+	for i := range endless.stepSeq(5) {
+		if i > 15 {
+			break
+		}
+		stepped = append(stepped, i)
+	}
+	if stepped.Join(",") != "1,6,11" {
+		t.Errorf("stepSeq() on endless Range expected 1,6,11, got %v", stepped)
+	}
+
+	pages := Array[Integer]{}
+	errDone := errors.New("done")
+	err := endless.TryEach(func(page Integer) error {
+		if page > 2 {
+			return errDone
+		}
+		pages = append(pages, page)
+		return nil
+	})
+	if !errors.Is(err, errDone) || pages.Join(",") != "1,2" {
+		t.Errorf("TryEach() on endless Range expected to stop at the first error, got %v (%v)", pages, err)
+	}
+
+	if endless.Size() != InfiniteSize || NewBeginlessRange(Integer(1)).Size() != InfiniteSize {
+		t.Errorf("Size() on unbounded Range expected InfiniteSize")
+	}
+	if endless.IsEmpty() {
+		t.Errorf("IsEmpty() on endless Range expected false")
+	}
+	if endless.First() != 1 || endless.Min() != 1 || NewBeginlessRange(Integer(10)).Last() != 10 || NewExclusiveBeginlessRange(Integer(10)).Last() != 9 {
+		t.Errorf("First()/Min()/Last() returned unexpected results on unbounded Ranges")
+	}
+}
+
+func TestRange_EndlessStopsAtLimit(t *testing.T) {
+	if result := slices.Collect(NewEndlessRange(Integer(math.MaxInt - 1)).Seq()); !slices.Equal(result, []Integer{math.MaxInt - 1, math.MaxInt}) {
+		t.Errorf("Seq() on endless Range expected to stop at MaxInt, got %v", result)
+	}
+	if result := slices.Collect(NewEndlessRange(Integer(math.MaxInt - 5)).stepSeq(4)); !slices.Equal(result, []Integer{math.MaxInt - 5, math.MaxInt - 1}) {
+		t.Errorf("stepSeq() on endless Range expected to stop before MaxInt, got %v", result)
+	}
+	if result := slices.Collect(NewEndlessRange(Float(1 << 53)).Seq()); !slices.Equal(result, []Float{1 << 53}) {
+		t.Errorf("Seq() on endless Float Range expected to stop once values stop changing, got %v", result)
+	}
+	if result := slices.Collect(NewEndlessRange(Float(math.MaxFloat64)).stepSeq(math.MaxFloat64)); !slices.Equal(result, []Float{math.MaxFloat64}) {
+		t.Errorf("stepSeq() on endless Float Range expected to stop before +Inf, got %v", result)
+	}
+}
+
+func TestRange_UnboundedErrors(t *testing.T) {
+	endless := NewEndlessRange(Integer(1))
+	beginless := NewBeginlessRange(Integer(10))
+
+	tests := []struct {
+		name    string
+		message string
+		fn      func()
+	}{
+		{"ToArray() on endless", "rb: range is unbounded: ToArray is not supported on endless range 1..", func() { endless.ToArray() }},
+		{"ToArray() on beginless", "rb: range is unbounded: ToArray is not supported on beginless range ..10", func() { beginless.ToArray() }},
+		{"Last() on endless", "rb: range is unbounded: Last is not supported on endless range 1..", func() { endless.Last() }},
+		{"Max() on endless", "rb: range is unbounded: Max is not supported on endless range 1..", func() { endless.Max() }},
+		{"First() on beginless", "rb: range is unbounded: First is not supported on beginless range ..10", func() { beginless.First() }},
+		{"Min() on beginless", "rb: range is unbounded: Min is not supported on beginless range ..10", func() { beginless.Min() }},
+		{"Each() on beginless", "rb: range is unbounded: iteration is not supported on beginless range ..10", func() { beginless.Each(func(Integer) {}) }},
+		{"Reverse() on endless", "rb: range is unbounded: Reverse is not supported on endless range 1..", func() { endless.Reverse() }},
+		{"Step() backwards on endless", "rb: range is unbounded: negative step is not supported on endless range 1..", func() { endless.Step(-1, func(Integer) {}) }},
+	}
+	for _, tt := range tests {
+		func() {
+			defer func() {
+				err, ok := recover().(error)
+				if !ok || !errors.Is(err, ErrUnboundedRange) || err.Error() != tt.message {
+					t.Errorf("%s expected to panic with %q, got %v", tt.name, tt.message, err)
+				}
+			}()
+			tt.fn()
+		}()
+	}
+
+	calls := 0
+	err := endless.TryStep(-1, func(Integer) error { calls++; return nil })
+	if !errors.Is(err, ErrUnboundedRange) || calls != 0 {
+		t.Errorf("TryStep() backwards on endless Range expected ErrUnboundedRange without calls, got %v after %d calls", err, calls)
+	}
+}
+
+func TestRange_UnboundedOverlapContains(t *testing.T) {
+	endless := NewEndlessRange(Integer(5))
+	beginless := NewBeginlessRange(Integer(5))
+
+	if !endless.Overlap(NewRange(Integer(1), Integer(5))) || endless.Overlap(NewRange(Integer(1), Integer(4))) {
+		t.Errorf("Overlap() on endless Range returned unexpected results")
+	}
+	if !endless.Overlap(beginless) || endless.Overlap(NewBeginlessRange(Integer(4))) {
+		t.Errorf("Overlap() between endless and beginless Ranges returned unexpected results")
+	}
+	if !endless.Contains(NewRange(Integer(6), Integer(100))) || endless.Contains(NewRange(Integer(4), Integer(6))) {
+		t.Errorf("Contains() on endless Range returned unexpected results")
+	}
+	if !endless.Contains(NewEndlessRange(Integer(10))) || NewRange(Integer(1), Integer(100)).Contains(endless) {
+		t.Errorf("Contains() with endless Ranges returned unexpected results")
+	}
+	if !beginless.Contains(NewBeginlessRange(Integer(0))) || beginless.Contains(endless) {
+		t.Errorf("Contains() on beginless Range returned unexpected results")
+	}
+}
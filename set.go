@@ -0,0 +1,252 @@
+// Package rb provides Ruby-inspired utility methods for Go types.
+package rb
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+)
+
+// Set is an insertion-ordered collection of unique values, similar to Ruby's Set.
+// Membership tests are O(1), and iteration follows insertion order.
+// The zero value is an empty Set ready to use.
+type Set[T comparable] struct {
+	keys *orderedKeys[T]
+}
+
+// NewSet creates a Set containing the given values. Pass an Array with arr... to convert it.
+// Example: NewSet(Integer(1), Integer(2), Integer(1)) -> #<Set: {1, 2}>
+func NewSet[T comparable](values ...T) *Set[T] {
+	s := &Set[T]{keys: newOrderedKeys[T](len(values))}
+	for _, v := range values {
+		s.keys.add(v)
+	}
+	return s
+}
+
+// SetFromHash creates a Set of the keys of a Hash.
+// Example: SetFromHash(Hash[string, int]{"a": 1}) -> #<Set: {"a"}>
+func SetFromHash[K comparable, V any](h Hash[K, V]) *Set[K] {
+	s := &Set[K]{keys: newOrderedKeys[K](len(h))}
+	for k := range h {
+		s.keys.add(k)
+	}
+	return s
+}
+
+// Classify groups the values of the Set into Sets keyed by the result of fn.
+// Example: Classify(NewSet(Integer(1), Integer(2), Integer(3)), Integer.IsOdd) -> {true: #<Set: {1, 3}>, false: #<Set: {2}>}
+func Classify[T, K comparable](s *Set[T], fn func(T) K) Hash[K, *Set[T]] {
+	result := make(Hash[K, *Set[T]])
+	s.Each(func(v T) {
+		key := fn(v)
+		if _, ok := result[key]; !ok {
+			result[key] = NewSet[T]()
+		}
+		result[key].Add(v)
+	})
+	return result
+}
+
+func (s *Set[T]) ordered() *orderedKeys[T] {
+	if s.keys == nil {
+		s.keys = newOrderedKeys[T](0)
+	}
+	return s.keys
+}
+
+// Add adds the value to the Set and returns the Set.
+// Example: NewSet(Integer(1)).Add(2) -> #<Set: {1, 2}>
+func (s *Set[T]) Add(value T) *Set[T] {
+	s.ordered().add(value)
+	return s
+}
+
+// IsAdded adds the value to the Set and reports whether it was not already present, like Ruby's add?.
+// Example: NewSet(Integer(1)).IsAdded(1) -> false
+func (s *Set[T]) IsAdded(value T) Boolean {
+	return Boolean(s.ordered().add(value))
+}
+
+// Delete removes the value from the Set and returns the Set.
+// Example: NewSet(Integer(1), Integer(2)).Delete(1) -> #<Set: {2}>
+func (s *Set[T]) Delete(value T) *Set[T] {
+	s.ordered().remove(value)
+	return s
+}
+
+// Include checks if the Set contains the value.
+// Example: NewSet(Integer(1)).Include(1) -> true
+func (s *Set[T]) Include(value T) Boolean {
+	return Boolean(s.ordered().has(value))
+}
+
+// Size returns the number of values in the Set.
+// Example: NewSet(Integer(1), Integer(2)).Size() -> 2
+func (s *Set[T]) Size() Integer {
+	return Integer(s.ordered().len())
+}
+
+// Length is an alias for Size.
+func (s *Set[T]) Length() Integer {
+	return s.Size()
+}
+
+// IsEmpty checks if the Set is empty.
+// Example: NewSet[Integer]().IsEmpty() -> true
+func (s *Set[T]) IsEmpty() Boolean {
+	return s.Size() == 0
+}
+
+// Union returns a new Set with the values of both Sets, like Ruby's |.
+// Example: NewSet(Integer(1), Integer(2)).Union(NewSet(Integer(2), Integer(3))) -> #<Set: {1, 2, 3}>
+func (s *Set[T]) Union(other *Set[T]) *Set[T] {
+	result := s.Clone()
+	other.Each(func(v T) { result.Add(v) })
+	return result
+}
+
+// Intersection returns a new Set with the values present in both Sets, like Ruby's &.
+// Example: NewSet(Integer(1), Integer(2)).Intersection(NewSet(Integer(2), Integer(3))) -> #<Set: {2}>
+func (s *Set[T]) Intersection(other *Set[T]) *Set[T] {
+	return s.Select(func(v T) bool { return bool(other.Include(v)) })
+}
+
+// Difference returns a new Set with the values not present in other, like Ruby's -.
+// Example: NewSet(Integer(1), Integer(2)).Difference(NewSet(Integer(2), Integer(3))) -> #<Set: {1}>
+func (s *Set[T]) Difference(other *Set[T]) *Set[T] {
+	return s.Reject(func(v T) bool { return bool(other.Include(v)) })
+}
+
+// SymmetricDifference returns a new Set with the values present in exactly one of the Sets, like Ruby's ^.
+// Example: NewSet(Integer(1), Integer(2)).SymmetricDifference(NewSet(Integer(2), Integer(3))) -> #<Set: {1, 3}>
+func (s *Set[T]) SymmetricDifference(other *Set[T]) *Set[T] {
+	return s.Difference(other).Union(other.Difference(s))
+}
+
+// IsSubset checks if every value of the Set is also in other, like Ruby's <=.
+// Example: NewSet(Integer(1)).IsSubset(NewSet(Integer(1), Integer(2))) -> true
+func (s *Set[T]) IsSubset(other *Set[T]) Boolean {
+	if s.Size() > other.Size() {
+		return false
+	}
+	for v := range s.Seq() {
+		if !other.Include(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset checks if every value of other is also in the Set, like Ruby's >=.
+// Example: NewSet(Integer(1), Integer(2)).IsSuperset(NewSet(Integer(1))) -> true
+func (s *Set[T]) IsSuperset(other *Set[T]) Boolean {
+	return other.IsSubset(s)
+}
+
+// IsProperSubset checks if the Set is a subset of other and smaller than it, like Ruby's <.
+func (s *Set[T]) IsProperSubset(other *Set[T]) Boolean {
+	return s.Size() < other.Size() && s.IsSubset(other)
+}
+
+// IsProperSuperset checks if the Set is a superset of other and larger than it, like Ruby's >.
+func (s *Set[T]) IsProperSuperset(other *Set[T]) Boolean {
+	return other.IsProperSubset(s)
+}
+
+// IsDisjoint checks if the Set has no values in common with other.
+// Example: NewSet(Integer(1)).IsDisjoint(NewSet(Integer(2))) -> true
+func (s *Set[T]) IsDisjoint(other *Set[T]) Boolean {
+	small, large := s, other
+	if small.Size() > large.Size() {
+		small, large = large, small
+	}
+	for v := range small.Seq() {
+		if large.Include(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsIntersect checks if the Set has at least one value in common with other.
+func (s *Set[T]) IsIntersect(other *Set[T]) Boolean {
+	return !s.IsDisjoint(other)
+}
+
+// Equal checks if both Sets contain the same values, regardless of order.
+// Example: NewSet(Integer(1), Integer(2)).Equal(NewSet(Integer(2), Integer(1))) -> true
+func (s *Set[T]) Equal(other *Set[T]) Boolean {
+	return s.Size() == other.Size() && s.IsSubset(other)
+}
+
+// Select returns a new Set containing the values for which the predicate returns true.
+// Example: NewSet(Integer(1), Integer(2)).Select(func(i Integer) bool { return i > 1 }) -> #<Set: {2}>
+func (s *Set[T]) Select(predicate func(T) bool) *Set[T] {
+	result := NewSet[T]()
+	s.Each(func(v T) {
+		if predicate(v) {
+			result.Add(v)
+		}
+	})
+	return result
+}
+
+// Reject returns a new Set containing the values for which the predicate returns false.
+// Example: NewSet(Integer(1), Integer(2)).Reject(func(i Integer) bool { return i > 1 }) -> #<Set: {1}>
+func (s *Set[T]) Reject(predicate func(T) bool) *Set[T] {
+	return s.Select(func(v T) bool { return !predicate(v) })
+}
+
+// Each applies the given function to each value in insertion order.
+// Example: NewSet(Integer(1), Integer(2)).Each(func(i Integer) { fmt.Println(i) })
+func (s *Set[T]) Each(fn func(T)) {
+	for v := range s.Seq() {
+		fn(v)
+	}
+}
+
+// Seq returns an iterator over the values in insertion order, for use with range-over-func.
+func (s *Set[T]) Seq() iter.Seq[T] {
+	return s.ordered().seq()
+}
+
+// Clone returns a copy of the Set.
+func (s *Set[T]) Clone() *Set[T] {
+	result := &Set[T]{keys: newOrderedKeys[T](s.ordered().len())}
+	s.Each(func(v T) { result.keys.add(v) })
+	return result
+}
+
+// Clear removes all values from the Set and returns it.
+func (s *Set[T]) Clear() *Set[T] {
+	s.keys = newOrderedKeys[T](0)
+	return s
+}
+
+// ToArray converts the Set to an Array in insertion order.
+// Example: NewSet(Integer(2), Integer(1)).ToArray() -> [2, 1]
+func (s *Set[T]) ToArray() Array[T] {
+	return ArrayFrom(s.Seq())
+}
+
+// ToHash converts the Set to a Hash mapping each value to true.
+// Example: NewSet("a").ToHash() -> {"a": true}
+func (s *Set[T]) ToHash() Hash[T, Boolean] {
+	result := make(Hash[T, Boolean], s.ordered().len())
+	s.Each(func(v T) { result[v] = true })
+	return result
+}
+
+// ToS converts the Set to a String representation in Ruby's inspect format.
+// Example: NewSet(Integer(1), Integer(2)).ToS() -> "#<Set: {1, 2}>"
+func (s *Set[T]) ToS() String {
+	parts := make([]string, 0, s.ordered().len())
+	s.Each(func(v T) { parts = append(parts, fmt.Sprintf("%v", v)) })
+	return String("#<Set: {" + strings.Join(parts, ", ") + "}>")
+}
+
+// String implements fmt.Stringer.
+func (s *Set[T]) String() string {
+	return string(s.ToS())
+}
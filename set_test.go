@@ -0,0 +1,188 @@
+package rb
+
+import (
+	"testing"
+)
+
+func TestSet_NewSet(t *testing.T) {
+	set := NewSet(Integer(3), Integer(1), Integer(3), Integer(2))
+
+	if set.Size() != 3 {
+		t.Errorf("NewSet() expected size 3, got %d", set.Size())
+	}
+	if set.ToArray().Join(",") != "3,1,2" {
+		t.Errorf("NewSet() expected insertion order 3,1,2, got %s", set.ToArray().Join(","))
+	}
+
+	arr := Array[String]{"a", "b", "a"}
+	if fromArray := NewSet(arr...); fromArray.Size() != 2 {
+		t.Errorf("NewSet(arr...) expected size 2, got %d", fromArray.Size())
+	}
+
+	var zero Set[Integer]
+	zero.Add(1)
+	if !zero.Include(1) {
+		t.Error("zero value Set should be usable")
+	}
+}
+
+func TestSet_AddDeleteInclude(t *testing.T) {
+	set := NewSet[String]()
+	set.Add("a").Add("b").Add("a")
+
+	if set.Size() != 2 || !set.Include("a") || set.Include("z") {
+		t.Errorf("Add()/Include() unexpected state %s", set)
+	}
+	if set.IsAdded("a") {
+		t.Error("IsAdded() should return false for existing value")
+	}
+	if !set.IsAdded("c") {
+		t.Error("IsAdded() should return true for new value")
+	}
+
+	set.Delete("a").Delete("missing")
+	if set.Include("a") || set.Size() != 2 {
+		t.Errorf("Delete() unexpected state %s", set)
+	}
+
+	// Re-adding after delete moves the value to the end
+	set.Add("a")
+	if set.ToArray().Join(",") != "b,c,a" {
+		t.Errorf("Add() after Delete() expected b,c,a, got %s", set.ToArray().Join(","))
+	}
+
+	if !set.Clear().IsEmpty() {
+		t.Error("Clear() should empty the Set")
+	}
+}
+
+func TestSet_OrderSurvivesManyDeletes(t *testing.T) {
+	set := NewSet(NewRange(Integer(1), Integer(100)).ToArray()...)
+	for i := Integer(1); i <= 90; i++ {
+		set.Delete(i)
+	}
+	set.Add(5)
+
+	if set.ToArray().Join(",") != "91,92,93,94,95,96,97,98,99,100,5" {
+		t.Errorf("order after deletes unexpected: %s", set.ToArray().Join(","))
+	}
+}
+
+func TestSet_Algebra(t *testing.T) {
+	a := NewSet(Integer(1), Integer(2), Integer(3))
+	b := NewSet(Integer(2), Integer(3), Integer(4))
+
+	tests := []struct {
+		name     string
+		result   *Set[Integer]
+		expected String
+	}{
+		{"Union", a.Union(b), "1,2,3,4"},
+		{"Intersection", a.Intersection(b), "2,3"},
+		{"Difference", a.Difference(b), "1"},
+		{"SymmetricDifference", a.SymmetricDifference(b), "1,4"},
+	}
+
+	for _, test := range tests {
+		if got := test.result.ToArray().Join(","); got != test.expected {
+			t.Errorf("%s() expected %s, got %s", test.name, test.expected, got)
+		}
+	}
+
+	if a.Size() != 3 || b.Size() != 3 {
+		t.Error("set operations should not modify their operands")
+	}
+}
+
+func TestSet_Relations(t *testing.T) {
+	small := NewSet(Integer(1), Integer(2))
+	big := NewSet(Integer(1), Integer(2), Integer(3))
+	other := NewSet(Integer(7))
+
+	if !small.IsSubset(big) || big.IsSubset(small) {
+		t.Error("IsSubset() returned unexpected result")
+	}
+	if !big.IsSuperset(small) || small.IsSuperset(big) {
+		t.Error("IsSuperset() returned unexpected result")
+	}
+	if !small.IsProperSubset(big) || big.IsProperSubset(big) {
+		t.Error("IsProperSubset() returned unexpected result")
+	}
+	if !big.IsProperSuperset(small) {
+		t.Error("IsProperSuperset() returned unexpected result")
+	}
+	if !small.IsDisjoint(other) || small.IsDisjoint(big) {
+		t.Error("IsDisjoint() returned unexpected result")
+	}
+	if !small.IsIntersect(big) {
+		t.Error("IsIntersect() returned unexpected result")
+	}
+	if !small.Equal(NewSet(Integer(2), Integer(1))) || small.Equal(big) {
+		t.Error("Equal() returned unexpected result")
+	}
+}
+
+func TestSet_SelectReject(t *testing.T) {
+	set := NewSet(Integer(1), Integer(2), Integer(3), Integer(4))
+	even := func(i Integer) bool { return bool(i.IsEven()) }
+
+	if got := set.Select(even).ToArray().Join(","); got != "2,4" {
+		t.Errorf("Select() expected 2,4, got %s", got)
+	}
+	if got := set.Reject(even).ToArray().Join(","); got != "1,3" {
+		t.Errorf("Reject() expected 1,3, got %s", got)
+	}
+}
+
+func TestClassify(t *testing.T) {
+	set := NewSet(Integer(1), Integer(2), Integer(3), Integer(4), Integer(5))
+	result := Classify(set, Integer.IsOdd)
+
+	if result.Size() != 2 {
+		t.Fatalf("Classify() expected 2 groups, got %d", result.Size())
+	}
+	if got := result[true].ToArray().Join(","); got != "1,3,5" {
+		t.Errorf("Classify() odd group expected 1,3,5, got %s", got)
+	}
+	if got := result[false].ToArray().Join(","); got != "2,4" {
+		t.Errorf("Classify() even group expected 2,4, got %s", got)
+	}
+}
+
+func TestSet_HashConversions(t *testing.T) {
+	set := SetFromHash(Hash[String, Integer]{"a": 1, "b": 2})
+	if set.Size() != 2 || !set.Include("a") || !set.Include("b") {
+		t.Errorf("SetFromHash() expected {a, b}, got %s", set)
+	}
+
+	hash := NewSet[String]("x", "y").ToHash()
+	if hash.Size() != 2 || !hash["x"] || !hash["y"] {
+		t.Errorf("ToHash() expected {x: true, y: true}, got %v", hash)
+	}
+}
+
+func TestSet_ToS(t *testing.T) {
+	set := NewSet(Integer(1), Integer(2))
+
+	if set.ToS() != "#<Set: {1, 2}>" {
+		t.Errorf("ToS() expected #<Set: {1, 2}>, got %s", set.ToS())
+	}
+	if NewSet[Integer]().String() != "#<Set: {}>" {
+		t.Errorf("String() expected #<Set: {}>, got %s", NewSet[Integer]().String())
+	}
+}
+
+func TestSet_Seq(t *testing.T) {
+	set := NewSet(String("a"), String("b"), String("c"))
+
+	visited := Array[String]{}
+	for v := range set.Seq() {
+		if v == "c" {
+			break
+		}
+		visited = visited.Push(v)
+	}
+	if visited.Join(",") != "a,b" {
+		t.Errorf("Seq() expected a,b, got %s", visited.Join(","))
+	}
+}
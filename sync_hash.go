@@ -0,0 +1,389 @@
+// Package rb provides Ruby-inspired utility methods for Go types.
+package rb
+
+import (
+	"hash/maphash"
+	"iter"
+	"math"
+	"reflect"
+	"sync"
+)
+
+// syncHashShards is the number of independently locked shards in a SyncHash.
+const syncHashShards = 32
+
+// SyncHash is a Hash that is safe for concurrent use by multiple goroutines.
+// Keys are spread over independently locked shards, so operations on different
+// keys rarely contend. Methods that return a plain Hash or Array, and Each and Seq,
+// work on a consistent snapshot taken with every shard locked at once.
+// The zero value is an empty SyncHash ready to use. A SyncHash must not be copied after first use.
+type SyncHash[K comparable, V any] struct {
+	once   sync.Once
+	seed   maphash.Seed
+	shards [syncHashShards]syncShard[K, V]
+}
+
+type syncShard[K comparable, V any] struct {
+	mu sync.RWMutex
+	m  map[K]V
+}
+
+// NewSyncHash creates an empty SyncHash.
+// Example: NewSyncHash[string, int]().Set("a", 1)
+func NewSyncHash[K comparable, V any]() *SyncHash[K, V] {
+	return &SyncHash[K, V]{}
+}
+
+// SyncHashFrom creates a SyncHash containing the pairs of a Hash.
+// Example: SyncHashFrom(Hash[string, int]{"a": 1}).Get("a", 0) -> 1
+func SyncHashFrom[K comparable, V any](h Hash[K, V]) *SyncHash[K, V] {
+	s := NewSyncHash[K, V]()
+	s.Update(h)
+	return s
+}
+
+func (s *SyncHash[K, V]) init() {
+	s.once.Do(func() {
+		s.seed = maphash.MakeSeed()
+		for i := range s.shards {
+			s.shards[i].m = make(map[K]V)
+		}
+	})
+}
+
+func (s *SyncHash[K, V]) shard(key K) *syncShard[K, V] {
+	s.init()
+
+	var h maphash.Hash
+	h.SetSeed(s.seed)
+	writeHashKey(&h, reflect.ValueOf(&key).Elem())
+	return &s.shards[h.Sum64()%syncHashShards]
+}
+
+// Get retrieves the value for the given key, returning a default value if the key doesn't exist.
+// Example: NewSyncHash[string, int]().Get("a", 0) -> 0
+func (s *SyncHash[K, V]) Get(key K, defaultValue V) V {
+	sh := s.shard(key)
+	sh.mu.RLock()
+	defer sh.mu.RUnlock()
+
+	if value, exists := sh.m[key]; exists {
+		return value
+	}
+	return defaultValue
+}
+
+// Fetch retrieves the value for the given key, returning a *KeyError if the key doesn't exist.
+// Example: SyncHashFrom(Hash[string, int]{"a": 1}).Fetch("a") -> 1, nil
+func (s *SyncHash[K, V]) Fetch(key K) (V, error) {
+	sh := s.shard(key)
+	sh.mu.RLock()
+	defer sh.mu.RUnlock()
+
+	if value, exists := sh.m[key]; exists {
+		return value, nil
+	}
+	var zero V
+	return zero, &KeyError{Key: key, Receiver: s}
+}
+
+// FetchOr retrieves the value for the given key, calling fallback with the key if it doesn't exist.
+// The fallback result is not stored; use FetchOrStore for that.
+func (s *SyncHash[K, V]) FetchOr(key K, fallback func(K) V) V {
+	if value, err := s.Fetch(key); err == nil {
+		return value
+	}
+	return fallback(key)
+}
+
+// FetchOrStore returns the value for the given key if it exists. Otherwise it stores and
+// returns value. The loaded result is true if the value was already present.
+// Example: NewSyncHash[string, int]().FetchOrStore("a", 1) -> 1, false
+func (s *SyncHash[K, V]) FetchOrStore(key K, value V) (V, bool) {
+	sh := s.shard(key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+
+	if existing, exists := sh.m[key]; exists {
+		return existing, true
+	}
+	sh.m[key] = value
+	return value, false
+}
+
+// Compute atomically replaces the value for the given key with the result of fn, which
+// receives the current value and whether it exists. If fn returns false as its second
+// result the key is deleted instead. Compute returns the new value and whether it was kept.
+// fn runs with the key's shard locked, so it must not use the SyncHash.
+// Example: s.Compute("hits", func(n int, _ bool) (int, bool) { return n + 1, true }) -> 1, true
+func (s *SyncHash[K, V]) Compute(key K, fn func(value V, exists bool) (V, bool)) (V, bool) {
+	sh := s.shard(key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+
+	current, exists := sh.m[key]
+	value, keep := fn(current, exists)
+	if keep {
+		sh.m[key] = value
+	} else {
+		delete(sh.m, key)
+	}
+	return value, keep
+}
+
+// Upsert atomically stores value for a missing key, or replaces an existing value with the
+// result of update, and returns the stored value. update runs with the key's shard locked,
+// so it must not use the SyncHash.
+// Example: s.Upsert("hits", 1, func(n int) int { return n + 1 }) -> 1
+func (s *SyncHash[K, V]) Upsert(key K, value V, update func(V) V) V {
+	result, _ := s.Compute(key, func(current V, exists bool) (V, bool) {
+		if exists {
+			return update(current), true
+		}
+		return value, true
+	})
+	return result
+}
+
+// HasKey checks if the SyncHash contains the given key.
+func (s *SyncHash[K, V]) HasKey(key K) Boolean {
+	_, err := s.Fetch(key)
+	return err == nil
+}
+
+// Set sets the value for the given key.
+// Example: NewSyncHash[string, int]().Set("a", 1)
+func (s *SyncHash[K, V]) Set(key K, value V) {
+	sh := s.shard(key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+
+	sh.m[key] = value
+}
+
+// Delete removes the key-value pair for the given key and returns the value.
+func (s *SyncHash[K, V]) Delete(key K) V {
+	sh := s.shard(key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+
+	value := sh.m[key]
+	delete(sh.m, key)
+	return value
+}
+
+// Clear removes all key-value pairs from the SyncHash.
+func (s *SyncHash[K, V]) Clear() {
+	s.DeleteIf(func(K, V) bool { return true })
+}
+
+// Size returns the number of key-value pairs in the SyncHash.
+func (s *SyncHash[K, V]) Size() Integer {
+	s.rlockAll()
+	defer s.runlockAll()
+
+	return Integer(s.size())
+}
+
+// Length is an alias for Size.
+func (s *SyncHash[K, V]) Length() Integer {
+	return s.Size()
+}
+
+// IsEmpty checks if the SyncHash is empty.
+func (s *SyncHash[K, V]) IsEmpty() Boolean {
+	return s.Size() == 0
+}
+
+// Update sets every key-value pair of other in the SyncHash.
+// Example: NewSyncHash[string, int]().Update(Hash[string, int]{"a": 1})
+func (s *SyncHash[K, V]) Update(other Hash[K, V]) {
+	for k, v := range other {
+		s.Set(k, v)
+	}
+}
+
+// EnforceMerge is an alias for Update.
+func (s *SyncHash[K, V]) EnforceMerge(other Hash[K, V]) {
+	s.Update(other)
+}
+
+// KeepIf keeps only the key-value pairs for which the predicate returns true.
+// Each shard is filtered atomically, and the predicate must not use the SyncHash.
+func (s *SyncHash[K, V]) KeepIf(predicate func(K, V) bool) {
+	s.DeleteIf(func(k K, v V) bool { return !predicate(k, v) })
+}
+
+// DeleteIf deletes the key-value pairs for which the predicate returns true.
+// Each shard is filtered atomically, and the predicate must not use the SyncHash.
+func (s *SyncHash[K, V]) DeleteIf(predicate func(K, V) bool) {
+	s.init()
+	for i := range s.shards {
+		sh := &s.shards[i]
+		sh.mu.Lock()
+		for k, v := range sh.m {
+			if predicate(k, v) {
+				delete(sh.m, k)
+			}
+		}
+		sh.mu.Unlock()
+	}
+}
+
+// Snapshot returns a copy of the SyncHash as a plain Hash, taken with every shard locked,
+// so it reflects a single point in time.
+func (s *SyncHash[K, V]) Snapshot() Hash[K, V] {
+	s.rlockAll()
+	defer s.runlockAll()
+
+	result := make(Hash[K, V], s.size())
+	for i := range s.shards {
+		for k, v := range s.shards[i].m {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// rlockAll read-locks every shard, always in the same order.
+func (s *SyncHash[K, V]) rlockAll() {
+	s.init()
+	for i := range s.shards {
+		s.shards[i].mu.RLock()
+	}
+}
+
+func (s *SyncHash[K, V]) runlockAll() {
+	for i := range s.shards {
+		s.shards[i].mu.RUnlock()
+	}
+}
+
+// size counts the pairs in every shard; the caller must hold all shard locks.
+func (s *SyncHash[K, V]) size() int {
+	size := 0
+	for i := range s.shards {
+		size += len(s.shards[i].m)
+	}
+	return size
+}
+
+// Seq returns an iterator over a snapshot of the key-value pairs. The SyncHash may be
+// used, and modified, while iterating.
+func (s *SyncHash[K, V]) Seq() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range s.Snapshot() {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// Each applies the given function to each key-value pair of a snapshot.
+func (s *SyncHash[K, V]) Each(fn func(K, V)) {
+	s.Snapshot().Each(fn)
+}
+
+// EachKey applies the given function to each key of a snapshot.
+func (s *SyncHash[K, V]) EachKey(fn func(K)) {
+	s.Snapshot().EachKey(fn)
+}
+
+// EachValue applies the given function to each value of a snapshot.
+func (s *SyncHash[K, V]) EachValue(fn func(V)) {
+	s.Snapshot().EachValue(fn)
+}
+
+// Keys returns an Array of the keys of a snapshot, in no particular order.
+func (s *SyncHash[K, V]) Keys() Array[K] {
+	return s.Snapshot().Keys()
+}
+
+// Values returns an Array of the values of a snapshot, in no particular order.
+func (s *SyncHash[K, V]) Values() Array[V] {
+	return s.Snapshot().Values()
+}
+
+// ToArray converts a snapshot to an Array of key-value pairs, in no particular order.
+func (s *SyncHash[K, V]) ToArray() Array[Pair[K, V]] {
+	return s.Snapshot().ToArray()
+}
+
+// Select returns a Hash containing the pairs of a snapshot for which the predicate returns true.
+func (s *SyncHash[K, V]) Select(predicate func(K, V) bool) Hash[K, V] {
+	return s.Snapshot().Select(predicate)
+}
+
+// Reject returns a Hash containing the pairs of a snapshot for which the predicate returns false.
+func (s *SyncHash[K, V]) Reject(predicate func(K, V) bool) Hash[K, V] {
+	return s.Snapshot().Reject(predicate)
+}
+
+// Map transforms a snapshot by applying the given function to each key-value pair.
+func (s *SyncHash[K, V]) Map(fn func(K, V) (K, V)) Hash[K, V] {
+	return s.Snapshot().Map(fn)
+}
+
+// Merge returns a Hash of a snapshot with the pairs of other merged in, overwriting existing keys.
+func (s *SyncHash[K, V]) Merge(other Hash[K, V]) Hash[K, V] {
+	result := s.Snapshot()
+	result.Update(other)
+	return result
+}
+
+// writeHashKey writes a representation of v to h such that values that compare equal
+// with == produce the same bytes.
+func writeHashKey(h *maphash.Hash, v reflect.Value) {
+	switch v.Kind() {
+	case reflect.String:
+		h.WriteString(v.String())
+	case reflect.Bool:
+		if v.Bool() {
+			h.WriteByte(1)
+		} else {
+			h.WriteByte(0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		writeHashUint(h, uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		writeHashUint(h, v.Uint())
+	case reflect.Float32, reflect.Float64:
+		writeHashFloat(h, v.Float())
+	case reflect.Complex64, reflect.Complex128:
+		writeHashFloat(h, real(v.Complex()))
+		writeHashFloat(h, imag(v.Complex()))
+	case reflect.Pointer, reflect.Chan, reflect.UnsafePointer:
+		writeHashUint(h, uint64(v.Pointer()))
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			writeHashKey(h, v.Index(i))
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			writeHashKey(h, v.Field(i))
+		}
+	case reflect.Interface:
+		if v.IsNil() {
+			h.WriteByte(0)
+			return
+		}
+		h.WriteString(v.Elem().Type().String())
+		writeHashKey(h, v.Elem())
+	}
+}
+
+func writeHashUint(h *maphash.Hash, n uint64) {
+	var buf [8]byte
+	for i := range buf {
+		buf[i] = byte(n >> (8 * i))
+	}
+	h.Write(buf[:])
+}
+
+func writeHashFloat(h *maphash.Hash, f float64) {
+	if f == 0 {
+		f = 0 // +0 and -0 are equal
+	}
+	writeHashUint(h, math.Float64bits(f))
+}
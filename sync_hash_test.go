@@ -0,0 +1,170 @@
+package rb
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestSyncHash_Basics(t *testing.T) {
+	var hash SyncHash[String, Integer]
+
+	if !hash.IsEmpty() || hash.Get("a", -1) != -1 {
+		t.Errorf("zero SyncHash expected to be empty")
+	}
+
+	hash.Set("a", 1)
+	hash.Update(Hash[String, Integer]{"b": 2, "c": 3})
+	if hash.Size() != 3 || !hash.HasKey("b") || hash.Get("c", 0) != 3 {
+		t.Errorf("Set()/Update() expected {a: 1, b: 2, c: 3}, got %v", hash.Snapshot())
+	}
+
+	if value, err := hash.Fetch("a"); err != nil || value != 1 {
+		t.Errorf("Fetch() for key 'a' expected 1, got %d (%v)", value, err)
+	}
+	var keyErr *KeyError
+	if _, err := hash.Fetch("z"); !errors.As(err, &keyErr) || keyErr.Key != String("z") || keyErr.Receiver != &hash {
+		t.Errorf("Fetch() for missing key expected *KeyError, got %v", err)
+	}
+	if hash.FetchOr("zz", func(k String) Integer { return k.Length() }) != 2 || hash.HasKey("zz") {
+		t.Errorf("FetchOr() expected fallback 2 without storing it")
+	}
+
+	if hash.Delete("a") != 1 || hash.HasKey("a") {
+		t.Errorf("Delete() expected to remove key 'a'")
+	}
+	if hash.Keys().Sort().Join(",") != "b,c" || hash.Values().Sort().Join(",") != "2,3" {
+		t.Errorf("Keys()/Values() expected b,c and 2,3, got %v", hash.Snapshot())
+	}
+
+	hash.Clear()
+	if !hash.IsEmpty() {
+		t.Errorf("Clear() expected empty SyncHash, got %v", hash.Snapshot())
+	}
+}
+
+func TestSyncHash_FetchOrStore(t *testing.T) {
+	hash := NewSyncHash[string, int]()
+
+	if value, loaded := hash.FetchOrStore("a", 1); value != 1 || loaded {
+		t.Errorf("FetchOrStore() on missing key expected 1, false, got %d, %t", value, loaded)
+	}
+	if value, loaded := hash.FetchOrStore("a", 2); value != 1 || !loaded {
+		t.Errorf("FetchOrStore() on existing key expected 1, true, got %d, %t", value, loaded)
+	}
+}
+
+func TestSyncHash_ComputeUpsert(t *testing.T) {
+	hash := NewSyncHash[string, int]()
+
+	if value := hash.Upsert("hits", 1, func(n int) int { return n + 1 }); value != 1 {
+		t.Errorf("Upsert() on missing key expected 1, got %d", value)
+	}
+	if value := hash.Upsert("hits", 1, func(n int) int { return n + 1 }); value != 2 {
+		t.Errorf("Upsert() on existing key expected 2, got %d", value)
+	}
+
+	value, kept := hash.Compute("hits", func(n int, exists bool) (int, bool) { return n * 10, exists })
+	if value != 20 || !kept || hash.Get("hits", 0) != 20 {
+		t.Errorf("Compute() expected 20, true, got %d, %t", value, kept)
+	}
+	if _, kept := hash.Compute("hits", func(n int, exists bool) (int, bool) { return 0, false }); kept || bool(hash.HasKey("hits")) {
+		t.Errorf("Compute() returning false expected to delete the key")
+	}
+}
+
+func TestSyncHash_SnapshotMethods(t *testing.T) {
+	hash := SyncHashFrom(Hash[String, Integer]{"a": 1, "b": 2, "c": 3})
+
+	selected := hash.Select(func(k String, v Integer) bool { return v > 1 })
+	if len(selected) != 2 || selected["a"] != 0 {
+		t.Errorf("Select() expected {b: 2, c: 3}, got %v", selected)
+	}
+	if rejected := hash.Reject(func(k String, v Integer) bool { return v > 1 }); len(rejected) != 1 || rejected["a"] != 1 {
+		t.Errorf("Reject() expected {a: 1}, got %v", rejected)
+	}
+	if merged := hash.Merge(Hash[String, Integer]{"a": 10, "d": 4}); len(merged) != 4 || merged["a"] != 10 || hash.Get("a", 0) != 1 {
+		t.Errorf("Merge() expected a copy with {a: 10, d: 4} merged in, got %v", merged)
+	}
+	if mapped := hash.Map(func(k String, v Integer) (String, Integer) { return k.Upcase(), v }); mapped["A"] != 1 {
+		t.Errorf("Map() expected {A: 1, ...}, got %v", mapped)
+	}
+	if pairs := hash.ToArray(); len(pairs) != 3 {
+		t.Errorf("ToArray() expected 3 pairs, got %v", pairs)
+	}
+
+	sum := Integer(0)
+	for k, v := range hash.Seq() {
+		hash.Delete(k) // modifying while iterating is allowed
+		sum += v
+	}
+	if sum != 6 || !hash.IsEmpty() {
+		t.Errorf("Seq() expected sum 6 and an empty SyncHash afterwards, got %d, %v", sum, hash.Snapshot())
+	}
+
+	hash = SyncHashFrom(Hash[String, Integer]{"a": 1, "b": 2, "c": 3})
+	hash.DeleteIf(func(k String, v Integer) bool { return v == 2 })
+	hash.KeepIf(func(k String, v Integer) bool { return k != "c" })
+	if snapshot := hash.Snapshot(); len(snapshot) != 1 || snapshot["a"] != 1 {
+		t.Errorf("DeleteIf()/KeepIf() expected {a: 1}, got %v", snapshot)
+	}
+}
+
+func TestSyncHash_KeyTypes(t *testing.T) {
+	type point struct {
+		X, Y Float
+		tag  string
+	}
+
+	points := NewSyncHash[point, string]()
+	points.Set(point{X: 0, Y: 1, tag: "p"}, "origin-ish")
+	if points.Get(point{X: Float(negativeZero()), Y: 1, tag: "p"}, "") != "origin-ish" {
+		t.Errorf("SyncHash expected -0 and 0 to find the same key")
+	}
+
+	anyKeys := NewSyncHash[any, int]()
+	anyKeys.Set(1, 1)
+	anyKeys.Set("1", 2)
+	anyKeys.Set(nil, 3)
+	if anyKeys.Get(1, 0) != 1 || anyKeys.Get("1", 0) != 2 || anyKeys.Get(nil, 0) != 3 || anyKeys.Size() != 3 {
+		t.Errorf("SyncHash[any] expected distinct keys 1, \"1\" and nil, got %v", anyKeys.Snapshot())
+	}
+}
+
+func negativeZero() float64 {
+	zero := 0.0
+	return -zero
+}
+
+func TestSyncHash_Concurrent(t *testing.T) {
+	hash := NewSyncHash[string, int]()
+
+	var wg sync.WaitGroup
+	for w := 0; w < 8; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < 200; i++ {
+				key := fmt.Sprintf("k%d", i%20)
+				hash.Upsert("total", 1, func(n int) int { return n + 1 })
+				hash.FetchOrStore(key, i)
+				hash.Set(fmt.Sprintf("w%d-%d", w, i), i)
+				hash.Get(key, 0)
+				if i%50 == 0 {
+					hash.Each(func(string, int) {})
+					hash.DeleteIf(func(k string, v int) bool { return k == fmt.Sprintf("w%d-%d", w, i) })
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	if total := hash.Get("total", 0); total != 1600 {
+		t.Errorf("Upsert() expected 1600 atomic increments, got %d", total)
+	}
+	// 1 total + 20 shared keys + 8 workers * 200 keys - 8 workers * 4 deleted keys
+	if size := hash.Size(); size != 1+20+1600-32 {
+		t.Errorf("Size() expected %d, got %d", 1+20+1600-32, size)
+	}
+}
@@ -0,0 +1,58 @@
+// Package rb provides Ruby-inspired utility methods for Go types.
+package rb
+
+import (
+	"errors"
+	"iter"
+)
+
+// ErrorMode selects how the Try methods, such as Array.TryMap, react to callback errors.
+type ErrorMode int
+
+const (
+	// StopOnError stops at the first callback error and returns it. It is the default.
+	StopOnError ErrorMode = iota
+	// CollectErrors keeps going after callback errors and returns all of them joined with errors.Join.
+	CollectErrors
+)
+
+// errorCollector accumulates callback errors according to an ErrorMode.
+type errorCollector struct {
+	mode ErrorMode
+	errs []error
+}
+
+func newErrorCollector(mode []ErrorMode) *errorCollector {
+	c := &errorCollector{mode: StopOnError}
+	if len(mode) > 0 {
+		c.mode = mode[0]
+	}
+	return c
+}
+
+// add records err and reports whether iteration should stop.
+func (c *errorCollector) add(err error) bool {
+	if err == nil {
+		return false
+	}
+	c.errs = append(c.errs, err)
+	return c.mode == StopOnError
+}
+
+// err returns the first error unchanged under StopOnError, and all of them joined otherwise.
+func (c *errorCollector) err() error {
+	if c.mode == StopOnError && len(c.errs) > 0 {
+		return c.errs[0]
+	}
+	return errors.Join(c.errs...)
+}
+
+func tryEachSeq[T any](seq iter.Seq[T], fn func(T) error, mode []ErrorMode) error {
+	errs := newErrorCollector(mode)
+	for v := range seq {
+		if errs.add(fn(v)) {
+			break
+		}
+	}
+	return errs.err()
+}
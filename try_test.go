@@ -0,0 +1,199 @@
+package rb
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+var errOdd = errors.New("odd")
+
+func failOnOdd(i Integer) error {
+	if i.IsOdd() {
+		return fmt.Errorf("%d: %w", i, errOdd)
+	}
+	return nil
+}
+
+func TestArray_TryMap(t *testing.T) {
+	double := func(i Integer) (Integer, error) { return i * 2, failOnOdd(i) }
+
+	result, err := Array[Integer]{2, 4}.TryMap(double)
+	if err != nil || result.Join(",") != "4,8" {
+		t.Errorf("TryMap() expected 4,8, got %v (%v)", result, err)
+	}
+
+	result, err = Array[Integer]{2, 3, 5}.TryMap(double)
+	if result != nil || err == nil || err.Error() != "3: odd" {
+		t.Errorf("TryMap() expected first error only, got %v (%v)", result, err)
+	}
+
+	_, err = Array[Integer]{2, 3, 5}.TryMap(double, CollectErrors)
+	if !errors.Is(err, errOdd) || err.Error() != "3: odd\n5: odd" {
+		t.Errorf("TryMap(CollectErrors) expected joined errors, got %v", err)
+	}
+}
+
+func TestTryMapTo(t *testing.T) {
+	parse := func(s String) (Integer, error) {
+		if s.ToI() == 0 {
+			return 0, fmt.Errorf("not a number: %s", s)
+		}
+		return s.ToI(), nil
+	}
+
+	result, err := TryMapTo(Array[String]{"1", "2"}, parse)
+	if err != nil || Sum(result) != 3 {
+		t.Errorf("TryMapTo() expected [1 2], got %v (%v)", result, err)
+	}
+
+	if _, err := TryMapTo(Array[String]{"1", "x"}, parse); err == nil {
+		t.Error("TryMapTo() expected error for non-numeric input")
+	}
+}
+
+func TestArray_TryEach(t *testing.T) {
+	visited := 0
+	err := Array[Integer]{2, 3, 4, 5}.TryEach(func(i Integer) error {
+		visited++
+		return failOnOdd(i)
+	})
+	if !errors.Is(err, errOdd) || visited != 2 {
+		t.Errorf("TryEach() should stop at first error, visited %d (%v)", visited, err)
+	}
+
+	err = Array[Integer]{2, 3}.TryEach(func(i Integer) error {
+		if i.IsOdd() {
+			return errOdd
+		}
+		return nil
+	})
+	if err != errOdd {
+		t.Errorf("TryEach() expected the callback error itself, got %#v", err)
+	}
+
+	visited = 0
+	err = Array[Integer]{2, 3, 4, 5}.TryEach(func(i Integer) error {
+		visited++
+		return failOnOdd(i)
+	}, CollectErrors)
+	if err == nil || visited != 4 {
+		t.Errorf("TryEach(CollectErrors) should visit all, visited %d (%v)", visited, err)
+	}
+}
+
+func TestArray_TrySelectReject(t *testing.T) {
+	big := func(i Integer) (bool, error) { return i > 2, nil }
+
+	selected, err := Array[Integer]{1, 2, 3, 4}.TrySelect(big)
+	if err != nil || selected.Join(",") != "3,4" {
+		t.Errorf("TrySelect() expected 3,4, got %v (%v)", selected, err)
+	}
+
+	rejected, err := Array[Integer]{1, 2, 3, 4}.TryReject(big)
+	if err != nil || rejected.Join(",") != "1,2" {
+		t.Errorf("TryReject() expected 1,2, got %v (%v)", rejected, err)
+	}
+
+	_, err = Array[Integer]{1, 2, 3}.TrySelect(func(i Integer) (bool, error) { return true, failOnOdd(i) }, CollectErrors)
+	if err == nil || err.Error() != "1: odd\n3: odd" {
+		t.Errorf("TrySelect(CollectErrors) expected joined errors, got %v", err)
+	}
+}
+
+func TestArray_TryInject(t *testing.T) {
+	add := func(acc, i Integer) (Integer, error) { return acc + i, failOnOdd(i) }
+
+	total, err := Array[Integer]{2, 4}.TryInject(10, add)
+	if err != nil || total != 16 {
+		t.Errorf("TryInject() expected 16, got %d (%v)", total, err)
+	}
+
+	total, err = Array[Integer]{2, 3}.TryInject(10, add)
+	if err == nil || total != 0 {
+		t.Errorf("TryInject() expected zero value and error, got %d (%v)", total, err)
+	}
+
+	lengths, err := TryReduce(Array[String]{"a", "bb"}, Integer(0), func(n Integer, s String) (Integer, error) {
+		return n + s.Length(), nil
+	})
+	if err != nil || lengths != 3 {
+		t.Errorf("TryReduce() expected 3, got %d (%v)", lengths, err)
+	}
+}
+
+func TestHash_TryEach(t *testing.T) {
+	hash := Hash[String, Integer]{"a": 1, "b": 2, "c": 3}
+
+	err := hash.TryEach(func(_ String, v Integer) error { return failOnOdd(v) })
+	if !errors.Is(err, errOdd) {
+		t.Errorf("TryEach() expected errOdd, got %v", err)
+	}
+
+	err = hash.TryEach(func(_ String, v Integer) error { return failOnOdd(v) }, CollectErrors)
+	if err == nil || len(err.(interface{ Unwrap() []error }).Unwrap()) != 2 {
+		t.Errorf("TryEach(CollectErrors) expected 2 errors, got %v", err)
+	}
+}
+
+func TestHash_TrySelectMap(t *testing.T) {
+	hash := Hash[String, Integer]{"a": 1, "b": 2}
+
+	selected, err := hash.TrySelect(func(_ String, v Integer) (bool, error) { return v > 1, nil })
+	if err != nil || selected.Size() != 1 || selected["b"] != 2 {
+		t.Errorf("TrySelect() expected {b: 2}, got %v (%v)", selected, err)
+	}
+
+	mapped, err := hash.TryMap(func(k String, v Integer) (String, Integer, error) { return k.Upcase(), v * 10, nil })
+	if err != nil || mapped["A"] != 10 || mapped["B"] != 20 {
+		t.Errorf("TryMap() expected {A: 10, B: 20}, got %v (%v)", mapped, err)
+	}
+
+	mapped, err = hash.TryMap(func(k String, v Integer) (String, Integer, error) { return k, v, failOnOdd(v) })
+	if mapped != nil || !errors.Is(err, errOdd) {
+		t.Errorf("TryMap() expected nil and errOdd, got %v (%v)", mapped, err)
+	}
+}
+
+func TestRange_TryEachStep(t *testing.T) {
+	visited := Array[Integer]{}
+	err := NewRange(Integer(2), Integer(6)).TryEach(func(i Integer) error {
+		visited = visited.Push(i)
+		return failOnOdd(i)
+	})
+	if !errors.Is(err, errOdd) || visited.Join(",") != "2,3" {
+		t.Errorf("TryEach() expected to stop at 3, visited %s (%v)", visited.Join(","), err)
+	}
+
+	visited = Array[Integer]{}
+	err = NewRange(Integer(0), Integer(10)).TryStep(3, func(i Integer) error {
+		visited = visited.Push(i)
+		return failOnOdd(i)
+	}, CollectErrors)
+	if visited.Join(",") != "0,3,6,9" || err == nil || err.Error() != "3: odd\n9: odd" {
+		t.Errorf("TryStep(CollectErrors) expected 0,3,6,9 with two errors, got %s (%v)", visited.Join(","), err)
+	}
+
+	beginless := NewBeginlessRange(Integer(5))
+	if err := beginless.TryEach(failOnOdd); !errors.Is(err, ErrUnboundedRange) {
+		t.Errorf("TryEach() on beginless Range expected ErrUnboundedRange, got %v", err)
+	}
+	if err := beginless.TryStep(2, failOnOdd); !errors.Is(err, ErrUnboundedRange) {
+		t.Errorf("TryStep() on beginless Range expected ErrUnboundedRange, got %v", err)
+	}
+}
+
+func TestInteger_TryTimes(t *testing.T) {
+	calls := 0
+	err := Integer(5).TryTimes(func(i Integer) error {
+		calls++
+		return failOnOdd(i)
+	})
+	if !errors.Is(err, errOdd) || calls != 2 {
+		t.Errorf("TryTimes() expected to stop after 2 calls, got %d (%v)", calls, err)
+	}
+
+	if err := Integer(3).TryTimes(func(Integer) error { return nil }); err != nil {
+		t.Errorf("TryTimes() expected nil error, got %v", err)
+	}
+}